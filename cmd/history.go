@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/neomantra/tempted/internal/dev"
+	"github.com/spf13/cobra"
+)
+
+var (
+	historyDescription = `Work with workflow histories.`
+
+	historyCmd = &cobra.Command{
+		Use:   "history",
+		Short: "Work with workflow histories",
+		Long:  historyDescription,
+	}
+
+	historyViewDescription = `Opens an exported workflow history JSON file in the History, Event and Timeline
+pages. No connection to Temporal is made.`
+
+	historyViewCmd = &cobra.Command{
+		Use:   "view <file.json>",
+		Short: "View an exported workflow history without a Temporal connection",
+		Long:  historyViewDescription,
+		Args:  cobra.ExactArgs(1),
+		Run:   historyViewEntrypoint,
+	}
+)
+
+func historyViewEntrypoint(cmd *cobra.Command, args []string) {
+	historyFile := args[0]
+	if _, err := os.Stat(historyFile); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	initialModel, options := setupHistoryView(cmd, historyFile)
+	program := tea.NewProgram(initialModel, options...)
+
+	dev.Debug("~STARTING UP HISTORY VIEW~")
+	if err := program.Start(); err != nil {
+		fmt.Printf("Error on tempted startup: %v", err)
+		os.Exit(1)
+	}
+}
@@ -53,16 +53,33 @@ var (
 	logoColorArg = arg{
 		cfgFileEnvVar: "tempted_logo_color",
 	}
+	profileArg = arg{
+		cliLong:       "profile",
+		cfgFileEnvVar: "tempted_profile",
+		description:   `Profile of the config file's "profiles" section, whose settings override the top-level ones. Default none`,
+	}
+	auditLogArg = arg{
+		cliLong:       "audit-log",
+		cfgFileEnvVar: "tempted_audit_log",
+		description:   `File to append a JSON line to for every action that changes server state. Default none`,
+	}
+	readOnlyArg = arg{
+		cliLong:       "read-only",
+		cfgFileEnvVar: "tempted_read_only",
+		description:   `Refuse and hide every action that changes workflows, batches or activities`,
+	}
 
 	description = `tempted is a terminal application for Temporal. It is used to
 view workflows, and more, all from the terminal in a productivity-focused UI.`
 
 	rootCmd = &cobra.Command{
-		Use:     "tempted",
-		Short:   "A terminal application for Temporal.",
-		Long:    description,
-		Run:     mainEntrypoint,
-		Version: getVersion(),
+		Use:   "tempted",
+		Short: "A terminal application for Temporal.",
+		Long:  description,
+		Run:   mainEntrypoint,
+		// PersistentPreRunE runs for subcommands too
+		PersistentPreRunE: validateProfile,
+		Version:           getVersion(),
 	}
 )
 
@@ -82,10 +99,13 @@ func init() {
 		addrArg,
 		namespaceArg,
 		updateSecondsArg,
+		profileArg,
+		auditLogArg,
 	} {
 		rootCmd.PersistentFlags().StringP(c.cliLong, c.cliShort, "", c.description)
 		viper.BindPFlag(c.cliLong, rootCmd.PersistentFlags().Lookup(c.cfgFileEnvVar))
 	}
+	rootCmd.PersistentFlags().Bool(readOnlyArg.cliLong, false, readOnlyArg.description)
 
 	// colors, config or env var only
 	viper.BindPFlag(logoColorArg.cliLong, rootCmd.PersistentFlags().Lookup(logoColorArg.cfgFileEnvVar))
@@ -102,6 +122,10 @@ func init() {
 	}
 
 	rootCmd.AddCommand(serveCmd)
+
+	// history
+	historyCmd.AddCommand(historyViewCmd)
+	rootCmd.AddCommand(historyCmd)
 }
 
 func initConfig() {
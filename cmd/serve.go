@@ -88,6 +88,10 @@ func serveEntrypoint(cmd *cobra.Command, args []string) {
 
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	if retrieveReadOnly(cmd) {
+		// every session gets the server's config, so sessions cannot leave read-only mode
+		log.Printf("Serving in read-only mode")
+	}
 	log.Printf("Starting SSH server on %s:%d", host, port)
 	go func() {
 		if err = s.ListenAndServe(); err != nil {
@@ -111,6 +115,7 @@ func generateTeaHandler(cmd *cobra.Command) func(charmssh.Session) (tea.Model, [
 		if sshCommands := s.Command(); len(sshCommands) == 1 {
 			overrideToken = strings.TrimSpace(sshCommands[0])
 		}
-		return setup(cmd, overrideToken)
+		// record who is behind each session in the audit log
+		return setupSession(cmd, overrideToken, s.User(), s.RemoteAddr().String())
 	}
 }
@@ -5,11 +5,13 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	charmssh "github.com/charmbracelet/ssh"
 	"github.com/charmbracelet/wish"
+	"github.com/neomantra/tempted/internal/audit"
 	"github.com/neomantra/tempted/internal/tui/components/app"
 	"github.com/neomantra/tempted/internal/tui/constants"
 	"github.com/spf13/cobra"
@@ -33,6 +35,12 @@ var (
 
 func retrieveWithDefault(cmd *cobra.Command, a arg, defaultVal string) string {
 	val := cmd.Flag(a.cliLong).Value.String()
+	if val == "" {
+		val = retrieveEnv(a)
+	}
+	if val == "" {
+		val = retrieveProfileValue(cmd, a)
+	}
 	if val == "" {
 		val = viper.GetString(a.cfgFileEnvVar)
 	}
@@ -50,6 +58,46 @@ func retrieveNonCLIWithDefault(a arg, defaultVal string) string {
 	return val
 }
 
+// retrieveEnv is the value of the environment variable of a, which viper's AutomaticEnv reads as the upper-cased key
+func retrieveEnv(a arg) string {
+	return os.Getenv(strings.ToUpper(a.cfgFileEnvVar))
+}
+
+func retrieveProfile(cmd *cobra.Command) (string, error) {
+	profile := cmd.Flag(profileArg.cliLong).Value.String()
+	if profile == "" {
+		profile = viper.GetString(profileArg.cfgFileEnvVar)
+	}
+	if profile != "" && !viper.IsSet("profiles."+profile) {
+		return "", fmt.Errorf("profile %s not found in the config file's profiles section", profile)
+	}
+	return profile, nil
+}
+
+// retrieveProfileValue is the value of a config key in the selected profile, if any.
+// Profiles take the precedence of the config file, below flags and environment variables.
+func retrieveProfileValue(cmd *cobra.Command, a arg) string {
+	if a == profileArg {
+		return ""
+	}
+	// an unknown profile is reported by validateProfile before any command runs
+	profile, err := retrieveProfile(cmd)
+	if err != nil || profile == "" {
+		return ""
+	}
+	return viper.GetString(fmt.Sprintf("profiles.%s.%s", profile, a.cfgFileEnvVar))
+}
+
+// validateProfile fails a command whose selected profile is not in the config file
+func validateProfile(cmd *cobra.Command, args []string) error {
+	_, err := retrieveProfile(cmd)
+	if err != nil {
+		// the usage does not help with a config file error
+		cmd.SilenceUsage = true
+	}
+	return err
+}
+
 func retrieveAddress(cmd *cobra.Command) string {
 	// TODO: validate host-port format
 	return retrieveWithDefault(cmd, addrArg, DEFAULT_TEMPORAL_ADDRESS)
@@ -69,6 +117,32 @@ func retrieveUpdateSeconds(cmd *cobra.Command) int {
 	return updateSeconds
 }
 
+func retrieveReadOnly(cmd *cobra.Command) bool {
+	// a bool flag is never empty, so only use it if given
+	readOnlyString := ""
+	if flag := cmd.Flag(readOnlyArg.cliLong); flag.Changed {
+		readOnlyString = flag.Value.String()
+	}
+	if readOnlyString == "" {
+		readOnlyString = retrieveEnv(readOnlyArg)
+	}
+	if readOnlyString == "" {
+		readOnlyString = retrieveProfileValue(cmd, readOnlyArg)
+	}
+	if readOnlyString == "" {
+		readOnlyString = viper.GetString(readOnlyArg.cfgFileEnvVar)
+	}
+	if readOnlyString == "" {
+		return false
+	}
+	readOnly, err := strconv.ParseBool(readOnlyString)
+	if err != nil {
+		fmt.Println(fmt.Errorf("read-only value %s cannot be converted to a boolean", readOnlyString))
+		os.Exit(1)
+	}
+	return readOnly
+}
+
 // customLoggingMiddleware provides basic connection logging. Connects are logged with the
 // remote address, invoked command, TERM setting, window dimensions and if the
 // auth was public key based. Disconnect will log the remote address and
@@ -86,20 +160,43 @@ func customLoggingMiddleware() wish.Middleware {
 	}
 }
 
-func setup(cmd *cobra.Command, overrideToken string) (app.Model, []tea.ProgramOption) {
+func retrieveAppConfig(cmd *cobra.Command) app.Config {
 	temporalAddr := retrieveAddress(cmd)
 	temporalNamespace := retrieveNamespace(cmd)
 	updateSeconds := retrieveUpdateSeconds(cmd)
 	logoColor := retrieveNonCLIWithDefault(logoColorArg, "")
+	readOnly := retrieveReadOnly(cmd)
+	auditLog := retrieveWithDefault(cmd, auditLogArg, "")
 
-	initialModel := app.InitialModel(app.Config{
+	return app.Config{
 		Version:       Version,
 		SHA:           CommitSHA,
 		HostPort:      temporalAddr,
 		Namespace:     temporalNamespace,
 		UpdateSeconds: time.Second * time.Duration(updateSeconds),
 		LogoColor:     logoColor,
-	})
+		ReadOnly:      readOnly,
+		AuditLog:      auditLog,
+		User:          audit.CurrentUser(),
+	}
+}
+
+func setup(cmd *cobra.Command, overrideToken string) (app.Model, []tea.ProgramOption) {
+	initialModel := app.InitialModel(retrieveAppConfig(cmd))
+	return initialModel, []tea.ProgramOption{tea.WithAltScreen()}
+}
+
+// setupSession is setup for an ssh session, whose user and address are audited
+func setupSession(cmd *cobra.Command, overrideToken, user, remote string) (app.Model, []tea.ProgramOption) {
+	config := retrieveAppConfig(cmd)
+	config.User, config.Remote = user, remote
+	return app.InitialModel(config), []tea.ProgramOption{tea.WithAltScreen()}
+}
+
+func setupHistoryView(cmd *cobra.Command, historyFile string) (app.Model, []tea.ProgramOption) {
+	config := retrieveAppConfig(cmd)
+	config.HistoryFile = historyFile
+	initialModel := app.InitialModel(config)
 	return initialModel, []tea.ProgramOption{tea.WithAltScreen()}
 }
 
@@ -0,0 +1,77 @@
+// Package audit appends a JSON line to a file for every operation that changes server state,
+// as a trail for incident reviews.
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"os/user"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mu serializes writes, which come from every session of tempted serve
+var mu sync.Mutex
+
+type Entry struct {
+	Time time.Time `json:"time"`
+	// User is the OS user, or the ssh user of a session of tempted serve
+	User string `json:"user"`
+	// Remote is the address of the ssh client of a session of tempted serve
+	Remote    string `json:"remote,omitempty"`
+	Address   string `json:"address"`
+	Namespace string `json:"namespace"`
+
+	Operation  string `json:"operation"`
+	WorkflowID string `json:"workflow_id,omitempty"`
+	RunID      string `json:"run_id,omitempty"`
+	ActivityID string `json:"activity_id,omitempty"`
+	BatchJobID string `json:"batch_job_id,omitempty"`
+	ScheduleID string `json:"schedule_id,omitempty"`
+	Query      string `json:"query,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+
+	// Result is "ok", or "error: " followed by the error
+	Result string `json:"result"`
+}
+
+// Log appends the entry to the file at path, creating it if needed. It does nothing if path is empty.
+func Log(path string, entry Entry) error {
+	if path == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Result describes the outcome of an operation for an Entry
+func Result(err error) string {
+	if err != nil {
+		return "error: " + strings.ReplaceAll(err.Error(), "\n", " ")
+	}
+	return "ok"
+}
+
+// CurrentUser is the OS user running tempted
+func CurrentUser() string {
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
@@ -2,21 +2,25 @@ package app
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	temporalClient "go.temporal.io/sdk/client"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/neomantra/tempted/internal/dev"
+	"github.com/neomantra/tempted/internal/tui/components/form"
 	"github.com/neomantra/tempted/internal/tui/components/header"
 	"github.com/neomantra/tempted/internal/tui/components/page"
 	"github.com/neomantra/tempted/internal/tui/constants"
 	"github.com/neomantra/tempted/internal/tui/formatter"
 	"github.com/neomantra/tempted/internal/tui/keymap"
 	"github.com/neomantra/tempted/internal/tui/message"
+	"github.com/neomantra/tempted/internal/tui/style"
 	"github.com/neomantra/tempted/internal/tui/temporaltui"
 )
 
@@ -33,6 +37,14 @@ type Config struct {
 	CopySavePath        bool
 	UpdateSeconds       time.Duration
 	LogoColor           string
+	// HistoryFile, if set, opens an exported workflow history without connecting to Temporal
+	HistoryFile string
+	// ReadOnly refuses actions that change server state
+	ReadOnly bool
+	// AuditLog, if set, is the file each action that changes server state is recorded in
+	AuditLog string
+	// User is who runs this session, and Remote their ssh client address if served
+	User, Remote string
 }
 
 type Model struct {
@@ -44,6 +56,35 @@ type Model struct {
 	pageModels  map[temporaltui.Page]*page.Model
 
 	workflowKey temporaltui.WorkflowKey
+	eventID     int64
+	diffKeys    []temporaltui.WorkflowKey
+	resetTarget temporaltui.ResetTarget
+	activity    temporaltui.ActivityTarget
+
+	// workflowsQuery is the visibility query of the Workflows page, listing all workflows if empty
+	workflowsQuery string
+	// batchJobID is the batch operation whose progress is shown
+	batchJobID string
+	// scheduleID is the schedule whose details are shown
+	scheduleID string
+	// taskQueue is the task queue whose pollers are shown
+	taskQueue string
+
+	// updates are the workflow updates sent or re-attached this session, and updateIdx the one whose result is shown
+	updates   []temporaltui.WorkflowUpdate
+	updateIdx int
+
+	// actionKeys are the workflows the action of the current page applies to
+	actionKeys []temporaltui.WorkflowKey
+	// actionValues are the values of the submitted form of the current action
+	actionValues form.Values
+
+	// cancelRequested holds the workflows asked to cancel, shown as such until they close. Cancels this session
+	// sent are added at once, and the history of a workflow whose details are loaded adds those of other clients.
+	cancelRequested map[temporaltui.WorkflowKey]bool
+
+	// actionReturnPage is the page to return to when a page that changes server state is done
+	actionReturnPage temporaltui.Page
 
 	updateID int
 
@@ -53,20 +94,30 @@ type Model struct {
 }
 
 func InitialModel(c Config) Model {
-	firstPage := temporaltui.WorkflowsPage
+	firstPage, location := temporaltui.WorkflowsPage, c.HostPort
+	if c.offline() {
+		firstPage, location = temporaltui.WorkflowHistoryPage, c.HistoryFile
+	}
 	initialHeader := header.New(
 		constants.LogoString,
 		c.LogoColor,
-		c.HostPort,
+		location,
 		getVersionString(c.Version, c.SHA),
-		temporaltui.GetPageKeyHelp(firstPage, false, false, false, false),
+		temporaltui.GetPageKeyHelp(firstPage, false, false, false, false, c.offline(), c.ReadOnly),
 	)
+	if !c.offline() {
+		initialHeader.Namespace = c.Namespace
+	}
+	if c.ReadOnly {
+		initialHeader.Badge = "READ-ONLY"
+	}
 
 	return Model{
-		config:      c,
-		header:      initialHeader,
-		currentPage: firstPage,
-		updateID:    nextUpdateID(),
+		config:          c,
+		header:          initialHeader,
+		currentPage:     firstPage,
+		cancelRequested: make(map[temporaltui.WorkflowKey]bool),
+		updateID:        nextUpdateID(),
 	}
 }
 
@@ -120,16 +171,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.getCurrentPageModel().SetAllPageData(msg.AllPageRows)
 			if m.currentPageLoading() {
 				m.getCurrentPageModel().SetViewportXOffset(0)
+				if msg.Err != nil {
+					m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: %v", msg.Err), style.ErrorToast)
+				}
 			}
 			m.getCurrentPageModel().SetLoading(false)
 
 			switch m.currentPage {
 			case temporaltui.WorkflowsPage:
 				if m.currentPage == temporaltui.WorkflowsPage && len(msg.AllPageRows) == 0 {
-					// oddly, nomad http api errors when one provides the wrong token, but returns empty results when one provides an empty token
+					noResults := fmt.Sprintf("No workflows in namespace %s.", m.config.Namespace)
+					if m.workflowsQuery != "" {
+						noResults = fmt.Sprintf("No workflows match the query %s. Press %s to change it.", m.workflowsQuery, keymap.KeyMap.Query.Help().Key)
+					}
 					m.getCurrentPageModel().SetAllPageData([]page.Row{
-						{"", "No job results. Is the cluster empty or no nomad token provided?"},
-						{"", "Press q or ctrl+c to quit."},
+						{Key: "", Row: noResults},
+						{Key: "", Row: "Press q or ctrl+c to quit."},
 					})
 					m.getCurrentPageModel().SetViewportSelectionEnabled(false)
 				}
@@ -137,6 +194,92 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, temporaltui.UpdatePageDataWithDelay(m.updateID, m.currentPage, m.config.UpdateSeconds))
 		}
 
+	case form.SubmittedMsg:
+		cmds = append(cmds, m.submitAction(msg.Values))
+
+	case form.CancelledMsg:
+		m.getCurrentPageModel().ClearForm()
+		m.setPage(m.actionReturnPage)
+		cmds = append(cmds, m.getCurrentPageCmd())
+
+	case temporaltui.ActionCompletedMsg:
+		// recorded even if the page was left, as the action reached the server regardless
+		m.auditActionCompleted(msg)
+		if msg.Page == m.currentPage {
+			m.completeAction(msg)
+			cmds = append(cmds, m.getCurrentPageCmd())
+		}
+
+	case temporaltui.WorkflowsActionProgressMsg:
+		if msg.Done() {
+			m.auditWorkflowsAction(msg)
+		}
+		if msg.Page == m.currentPage {
+			if !msg.Done() {
+				m.getCurrentPageModel().SetLoadingString(fmt.Sprintf("%s (%s)", m.currentPage.LoadingString(), msg.Progress()))
+				cmds = append(cmds, msg.Next())
+			} else {
+				m.completeWorkflowsAction(msg)
+				cmds = append(cmds, m.getCurrentPageCmd())
+			}
+		}
+
+	case temporaltui.FormMsg:
+		if msg.Err != nil {
+			m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: %v", msg.Err), style.ErrorToast)
+		} else {
+			cmds = append(cmds, m.openActionPage(msg.Page, msg.Form))
+		}
+
+	case temporaltui.TaskQueueMsg:
+		if msg.Err != nil {
+			m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: %v", msg.Err), style.ErrorToast)
+		} else {
+			m.taskQueue = msg.TaskQueue
+			m.setPage(temporaltui.TaskQueuePage)
+			cmds = append(cmds, m.getCurrentPageCmd())
+		}
+
+	case temporaltui.WorkflowsQueryMsg:
+		if m.currentPage == temporaltui.WorkflowsQueryPage {
+			if msg.Err == nil {
+				m.workflowsQuery = msg.Query
+			}
+			m.completeAction(msg.Completed())
+			cmds = append(cmds, m.getCurrentPageCmd())
+		}
+
+	case temporaltui.UpdateCompletedMsg:
+		if msg.Page.ChangesServerState() {
+			m.auditUpdate(msg.Update)
+		}
+		if msg.Page == m.currentPage {
+			m.updates = append(m.updates, msg.Update)
+			m.updateIdx = len(m.updates) - 1
+			m.setPage(temporaltui.WorkflowUpdateResultPage)
+			if text, err := msg.Update.Message(); err != nil {
+				m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: %v", err), style.ErrorToast)
+			} else {
+				m.getCurrentPageModel().SetToast(text, style.SuccessToast)
+			}
+			cmds = append(cmds, m.getCurrentPageCmd())
+		}
+
+	case temporaltui.CancelRequestedMsg:
+		// on error, the details page shows why the workflow could not be read
+		if msg.Err == nil {
+			if msg.CancelRequested {
+				m.cancelRequested[msg.Key] = true
+			} else {
+				delete(m.cancelRequested, msg.Key)
+			}
+		}
+
+	case temporaltui.SignalNamesMsg:
+		if msg.Page == m.currentPage {
+			m.getCurrentPageModel().SetFormSuggestions("name", msg.Names)
+		}
+
 	case temporaltui.UpdatePageDataMsg:
 		if msg.ID == m.updateID && msg.Page == m.currentPage {
 			cmds = append(cmds, m.getCurrentPageCmd())
@@ -173,19 +316,53 @@ func (m Model) View() string {
 }
 
 func (m *Model) initialize() error {
-	client, err := m.config.client()
-	if err != nil {
-		return err
+	if !m.config.offline() {
+		client, err := m.config.client()
+		if err != nil {
+			return err
+		}
+		m.client = *client
 	}
-	m.client = *client
 
+	m.resetPageModels()
+	m.getCurrentPageModel().SetFilterPrefix(m.getFilterPrefix(m.currentPage))
+
+	m.initialized = true
+	return nil
+}
+
+func (m *Model) resetPageModels() {
 	m.pageModels = make(map[temporaltui.Page]*page.Model)
 	for k, c := range temporaltui.GetAllPageConfigs(m.width, m.getPageHeight(), m.config.CopySavePath) {
 		p := page.New(c)
 		m.pageModels[k] = &p
 	}
+}
 
-	m.initialized = true
+// switchNamespace recreates the client for another namespace, forgetting what was shown of the previous one
+func (m *Model) switchNamespace(namespace string) error {
+	if namespace != m.config.Namespace {
+		config := m.config
+		config.Namespace = namespace
+		client, err := config.client()
+		if err != nil {
+			return err
+		}
+		m.client.Close()
+		m.client, m.config = *client, config
+
+		m.workflowKey, m.eventID, m.diffKeys = temporaltui.WorkflowKey{}, 0, nil
+		m.resetTarget, m.activity = temporaltui.ResetTarget{}, temporaltui.ActivityTarget{}
+		m.workflowsQuery, m.batchJobID, m.scheduleID, m.taskQueue = "", "", "", ""
+		m.updates, m.updateIdx = nil, 0
+		m.actionKeys, m.actionValues = nil, nil
+		m.cancelRequested = make(map[temporaltui.WorkflowKey]bool)
+		// drops updates still scheduled for the pages of the previous namespace
+		m.updateID = nextUpdateID()
+		m.resetPageModels()
+		m.header.Namespace = namespace
+	}
+	m.setPage(temporaltui.WorkflowsPage)
 	return nil
 }
 
@@ -219,13 +396,42 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 		}
 	}
 
-	if !m.currentPageFilterFocused() && !m.currentPageViewportSaving() {
+	if !m.currentPageFilterFocused() && !m.currentPageViewportSaving() && !currentPageModel.EnteringInput() {
 		switch {
 		case key.Matches(msg, keymap.KeyMap.Forward):
-			if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+			selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow()
+			// workflow details has no selectable rows, but leads on to the history
+			if err == nil || m.currentPage == temporaltui.WorkflowDetailsPage {
 				switch m.currentPage {
 				case temporaltui.WorkflowsPage:
 					m.workflowKey = temporaltui.WorkflowKeyFromString(selectedPageRow.Key)
+				case temporaltui.BatchOperationsPage:
+					m.batchJobID = selectedPageRow.Key
+				case temporaltui.WorkflowUpdatesPage:
+					m.updateIdx = temporaltui.UpdateIdxFromKey(selectedPageRow.Key)
+				case temporaltui.SchedulesPage:
+					m.scheduleID = selectedPageRow.Key
+				case temporaltui.NamespacesPage:
+					if err := m.switchNamespace(selectedPageRow.Key); err != nil {
+						m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: %v", err), style.ErrorToast)
+						return nil
+					}
+					return m.getCurrentPageCmd()
+				case temporaltui.ScheduleDetailsPage:
+					// only the rows of workflow runs lead on
+					if selectedPageRow.Key == "" {
+						return nil
+					}
+					m.workflowKey = temporaltui.WorkflowKeyFromString(selectedPageRow.Key)
+				case temporaltui.WorkflowHistoryPage, temporaltui.HistoryTimelinePage:
+					m.eventID = temporaltui.EventIDFromKey(selectedPageRow.Key)
+				case temporaltui.WorkflowResetPointsPage:
+					if !temporaltui.ResetPointResettableFromKey(selectedPageRow.Key) {
+						m.getCurrentPageModel().SetToast("Error: the server does not allow a reset to this reset point", style.ErrorToast)
+						return nil
+					}
+					m.resetTarget = temporaltui.ResetTargetFromResetPointKey(m.workflowKey.WorkflowID, selectedPageRow.Key)
+					return m.openActionPage(temporaltui.WorkflowResetPage, temporaltui.ResetWorkflowForm(m.resetTarget))
 				}
 				nextPage := m.currentPage.Forward()
 				if nextPage != m.currentPage {
@@ -236,10 +442,21 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 
 		case key.Matches(msg, keymap.KeyMap.Back):
 			if !m.currentPageFilterApplied() {
-				switch m.currentPage {
+				if !m.currentPage.DoesLoad() {
+					if m.currentPageLoading() {
+						// stay until the action completes, so its progress and result are not lost
+						return nil
+					}
+					// leave the results of an action page
+					m.setPage(m.actionReturnPage)
+					return m.getCurrentPageCmd()
 				}
 
 				backPage := m.currentPage.Backward()
+				if m.config.offline() && backPage == temporaltui.WorkflowDetailsPage {
+					// an offline history has no workflow details to go back to
+					backPage = m.currentPage
+				}
 				if backPage != m.currentPage {
 					m.setPage(backPage)
 					cmds = append(cmds, m.getCurrentPageCmd())
@@ -254,17 +471,235 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 			}
 		}
 
-		if key.Matches(msg, keymap.KeyMap.Term) {
+		if key.Matches(msg, keymap.KeyMap.Term) && m.setActionKeys() {
+			return m.openActionPage(temporaltui.WorkflowTermPage, temporaltui.TerminateWorkflowForm(m.actionKeys))
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Cancel) && m.setActionKeys() {
+			return m.openActionPage(temporaltui.WorkflowCancelPage, temporaltui.CancelWorkflowForm(m.actionKeys))
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Delete) && m.setActionKeys() {
+			return m.openActionPage(temporaltui.WorkflowDeletePage, temporaltui.DeleteWorkflowForm(m.actionKeys))
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Signal) && m.setActionKeys() {
+			// suggest the signal names the first workflow has received before
+			source := temporaltui.HistorySource{Client: m.client, Key: m.actionKeys[0]}
+			return tea.Batch(
+				m.openActionPage(temporaltui.WorkflowSignalPage, temporaltui.SignalWorkflowForm(m.actionKeys)),
+				temporaltui.FetchSignalNames(source, temporaltui.WorkflowSignalPage),
+			)
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Start) && !m.config.offline() {
+			switch m.currentPage {
+			case temporaltui.WorkflowsPage:
+				// pre-fill from the selected workflow, if any
+				if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+					return temporaltui.FetchStartWorkflowForm(m.client, temporaltui.WorkflowKeyFromString(selectedPageRow.Key), temporaltui.WorkflowStartPage)
+				}
+				return m.openActionPage(temporaltui.WorkflowStartPage, temporaltui.StartWorkflowForm("Start Workflow", temporaltui.StartWorkflowParams{}))
+			case temporaltui.WorkflowDetailsPage:
+				return temporaltui.FetchStartWorkflowForm(m.client, m.workflowKey, temporaltui.WorkflowStartPage)
+			}
+		}
+
+		if key.Matches(msg, keymap.KeyMap.SigStart) && !m.config.offline() {
+			switch m.currentPage {
+			case temporaltui.WorkflowsPage:
+				if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+					return temporaltui.FetchStartWorkflowForm(m.client, temporaltui.WorkflowKeyFromString(selectedPageRow.Key), temporaltui.WorkflowSignalWithStartPage)
+				}
+				return m.openActionPage(temporaltui.WorkflowSignalWithStartPage, temporaltui.SignalWithStartWorkflowForm(temporaltui.StartWorkflowParams{}, nil))
+			case temporaltui.WorkflowDetailsPage:
+				return temporaltui.FetchStartWorkflowForm(m.client, m.workflowKey, temporaltui.WorkflowSignalWithStartPage)
+			}
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Rerun) && !m.config.offline() {
+			switch m.currentPage {
+			case temporaltui.WorkflowsPage:
+				if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+					return temporaltui.FetchRerunWorkflowForm(m.client, temporaltui.WorkflowKeyFromString(selectedPageRow.Key))
+				}
+			case temporaltui.WorkflowDetailsPage:
+				return temporaltui.FetchRerunWorkflowForm(m.client, m.workflowKey)
+			}
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Update) && !m.config.offline() {
+			switch m.currentPage {
+			case temporaltui.WorkflowsPage:
+				if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+					m.workflowKey = temporaltui.WorkflowKeyFromString(selectedPageRow.Key)
+					return m.openActionPage(temporaltui.WorkflowUpdatePage, temporaltui.UpdateWorkflowForm(m.workflowKey))
+				}
+			case temporaltui.WorkflowDetailsPage:
+				return m.openActionPage(temporaltui.WorkflowUpdatePage, temporaltui.UpdateWorkflowForm(m.workflowKey))
+			}
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Activities) && m.currentPage == temporaltui.WorkflowDetailsPage {
+			m.setPage(temporaltui.PendingActivitiesPage)
+			return m.getCurrentPageCmd()
+		}
+
+		if m.currentPage == temporaltui.PendingActivitiesPage {
 			if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
-				switch m.currentPage {
-				case temporaltui.WorkflowsPage:
+				target := temporaltui.ActivityTarget{Key: m.workflowKey, ActivityID: selectedPageRow.Key}
+				switch {
+				case key.Matches(msg, keymap.KeyMap.Complete):
+					m.activity = target
+					return m.openActionPage(temporaltui.ActivityCompletePage, temporaltui.CompleteActivityForm(target))
+				case key.Matches(msg, keymap.KeyMap.Fail):
+					m.activity = target
+					return m.openActionPage(temporaltui.ActivityFailPage, temporaltui.FailActivityForm(target))
+				case key.Matches(msg, keymap.KeyMap.Heartbeat):
+					m.activity = target
+					return m.openActionPage(temporaltui.ActivityHeartbeatPage, temporaltui.HeartbeatActivityForm(target))
+				}
+			}
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Updates) && m.currentPage == temporaltui.WorkflowsPage {
+			m.setPage(temporaltui.WorkflowUpdatesPage)
+			return m.getCurrentPageCmd()
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Attach) && m.currentPage == temporaltui.WorkflowUpdatesPage {
+			return m.openActionPage(temporaltui.WorkflowUpdateAttachPage, temporaltui.AttachUpdateForm())
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Diff) && m.currentPage == temporaltui.WorkflowsPage {
+			marked := m.getCurrentPageModel().MarkedRows()
+			if len(marked) != 2 {
+				m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: mark two workflows with %s to diff them, %d marked", keymap.KeyMap.Mark.Help().Key, len(marked)), style.ErrorToast)
+				return nil
+			}
+			m.diffKeys = []temporaltui.WorkflowKey{temporaltui.WorkflowKeyFromString(marked[0].Key), temporaltui.WorkflowKeyFromString(marked[1].Key)}
+			m.setPage(temporaltui.WorkflowDiffPage)
+			return m.getCurrentPageCmd()
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Reset) && !m.config.offline() {
+			switch m.currentPage {
+			case temporaltui.WorkflowsPage:
+				if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
 					m.workflowKey = temporaltui.WorkflowKeyFromString(selectedPageRow.Key)
-					m.setPage(temporaltui.WorkflowTermPage)
+					m.setPage(temporaltui.WorkflowResetPointsPage)
 					return m.getCurrentPageCmd()
 				}
+			case temporaltui.WorkflowDetailsPage:
+				m.setPage(temporaltui.WorkflowResetPointsPage)
+				return m.getCurrentPageCmd()
+			case temporaltui.WorkflowHistoryPage:
+				if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+					if temporaltui.EventTypeFromKey(selectedPageRow.Key) != enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
+						m.getCurrentPageModel().SetToast("Error: select a WorkflowTaskCompleted event to reset to", style.ErrorToast)
+						return nil
+					}
+					m.resetTarget = temporaltui.ResetTarget{Key: m.workflowKey, EventID: temporaltui.EventIDFromKey(selectedPageRow.Key)}
+					return m.openActionPage(temporaltui.WorkflowResetPage, temporaltui.ResetWorkflowForm(m.resetTarget))
+				}
+			}
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Query) && m.currentPage == temporaltui.WorkflowsPage {
+			return m.openActionPage(temporaltui.WorkflowsQueryPage, temporaltui.WorkflowsQueryForm(m.workflowsQuery))
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Batch) && m.currentPage == temporaltui.WorkflowsPage {
+			if m.workflowsQuery == "" {
+				m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: set a query with %s to choose the workflows of a batch", keymap.KeyMap.Query.Help().Key), style.ErrorToast)
+				return nil
+			}
+			return temporaltui.FetchBatchForm(m.client, m.workflowsQuery)
+		}
+
+		if m.currentPage == temporaltui.SchedulesPage || m.currentPage == temporaltui.ScheduleDetailsPage {
+			if key.Matches(msg, keymap.KeyMap.Create) {
+				return m.openActionPage(temporaltui.ScheduleCreatePage, temporaltui.CreateScheduleForm())
+			}
+			if m.currentPage == temporaltui.SchedulesPage {
+				if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+					m.scheduleID = selectedPageRow.Key
+				}
+			}
+			if m.scheduleID != "" {
+				switch {
+				case key.Matches(msg, keymap.KeyMap.Edit):
+					return temporaltui.FetchEditScheduleForm(m.client, m.scheduleID)
+				case key.Matches(msg, keymap.KeyMap.Pause):
+					return temporaltui.FetchSchedulePauseForm(m.client, m.scheduleID)
+				case key.Matches(msg, keymap.KeyMap.Trigger):
+					return m.openActionPage(temporaltui.ScheduleTriggerPage, temporaltui.ScheduleTriggerForm(m.scheduleID))
+				case key.Matches(msg, keymap.KeyMap.Backfill):
+					return m.openActionPage(temporaltui.ScheduleBackfillPage, temporaltui.ScheduleBackfillForm(m.scheduleID))
+				case key.Matches(msg, keymap.KeyMap.Delete):
+					return m.openActionPage(temporaltui.ScheduleDeletePage, temporaltui.ScheduleDeleteForm(m.scheduleID))
+				}
+			}
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Queue) && !m.config.offline() {
+			switch m.currentPage {
+			case temporaltui.WorkflowsPage:
+				if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+					return temporaltui.FetchWorkflowTaskQueue(m.client, temporaltui.WorkflowKeyFromString(selectedPageRow.Key))
+				}
+			case temporaltui.WorkflowDetailsPage:
+				return temporaltui.FetchWorkflowTaskQueue(m.client, m.workflowKey)
+			}
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Running) && m.currentPage == temporaltui.TaskQueuePage {
+			m.workflowsQuery = temporaltui.RunningWorkflowsQuery(m.taskQueue)
+			m.setPage(temporaltui.WorkflowsPage)
+			return m.getCurrentPageCmd()
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Versions) && m.currentPage == temporaltui.TaskQueuePage {
+			m.setPage(temporaltui.BuildIDsPage)
+			return m.getCurrentPageCmd()
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Task) && m.currentPage == temporaltui.WorkflowsPage {
+			return m.openActionPage(temporaltui.TaskQueueSelectPage, temporaltui.TaskQueueForm(m.taskQueue))
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Namespaces) && m.currentPage == temporaltui.WorkflowsPage {
+			m.setPage(temporaltui.NamespacesPage)
+			return m.getCurrentPageCmd()
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Schedules) && m.currentPage == temporaltui.WorkflowsPage {
+			m.setPage(temporaltui.SchedulesPage)
+			return m.getCurrentPageCmd()
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Batches) && m.currentPage == temporaltui.WorkflowsPage {
+			m.setPage(temporaltui.BatchOperationsPage)
+			return m.getCurrentPageCmd()
+		}
+
+		if key.Matches(msg, keymap.KeyMap.Stop) {
+			switch m.currentPage {
+			case temporaltui.BatchOperationsPage:
+				if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+					m.batchJobID = selectedPageRow.Key
+					return m.openActionPage(temporaltui.BatchStopPage, temporaltui.StopBatchOperationForm(m.batchJobID))
+				}
+			case temporaltui.BatchOperationPage:
+				return m.openActionPage(temporaltui.BatchStopPage, temporaltui.StopBatchOperationForm(m.batchJobID))
 			}
 		}
 
+		if key.Matches(msg, keymap.KeyMap.Timeline) && m.currentPage == temporaltui.WorkflowHistoryPage {
+			m.setPage(temporaltui.HistoryTimelinePage)
+			return m.getCurrentPageCmd()
+		}
+
 		//if key.Matches(msg, keymap.KeyMap.JobEvents) && m.currentPage == temporaltui.JobsPage {
 		// if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
 		// 	m.jobID, m.jobNamespace = temporaltui.JobIDAndNamespaceFromKey(selectedPageRow.Key)
@@ -278,6 +713,169 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 	return nil
 }
 
+// openActionPage opens a page that changes server state once its form is submitted
+func (m *Model) openActionPage(p temporaltui.Page, f form.Model) tea.Cmd {
+	if m.config.ReadOnly && p.ChangesServerState() {
+		m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: %s is not allowed in read-only mode", p), style.ErrorToast)
+		return nil
+	}
+	if hidden := m.getCurrentPageModel().HiddenMarkCount(); hidden > 0 && m.currentPage == temporaltui.WorkflowsPage {
+		switch p {
+		case temporaltui.WorkflowTermPage, temporaltui.WorkflowCancelPage, temporaltui.WorkflowSignalPage, temporaltui.WorkflowDeletePage:
+			// the action applies to marked workflows the filter hides too
+			f.AddInfo("", fmt.Sprintf("%d of the marked workflows are hidden by the filter.", hidden))
+		}
+	}
+	m.actionReturnPage = m.currentPage
+	m.setPage(p)
+	return m.getCurrentPageModel().SetForm(f)
+}
+
+// setActionKeys sets the workflows an action applies to: the marked workflows, else the selected or current one
+func (m *Model) setActionKeys() bool {
+	if m.config.offline() {
+		return false
+	}
+	switch m.currentPage {
+	case temporaltui.WorkflowsPage:
+		if marked := m.getCurrentPageModel().MarkedRows(); len(marked) > 0 {
+			m.actionKeys = nil
+			for _, row := range marked {
+				m.actionKeys = append(m.actionKeys, temporaltui.WorkflowKeyFromString(row.Key))
+			}
+			return true
+		}
+		if selectedPageRow, err := m.getCurrentPageModel().GetSelectedPageRow(); err == nil {
+			m.workflowKey = temporaltui.WorkflowKeyFromString(selectedPageRow.Key)
+			m.actionKeys = []temporaltui.WorkflowKey{m.workflowKey}
+			return true
+		}
+	case temporaltui.WorkflowDetailsPage:
+		m.actionKeys = []temporaltui.WorkflowKey{m.workflowKey}
+		return true
+	}
+	return false
+}
+
+// completeWorkflowsAction records an action on several workflows once it is done
+func (m *Model) completeWorkflowsAction(msg temporaltui.WorkflowsActionProgressMsg) {
+	if msg.Page == temporaltui.WorkflowCancelPage {
+		for _, k := range msg.Succeeded() {
+			m.cancelRequested[k] = true
+		}
+	}
+
+	if msg.Page == temporaltui.WorkflowDeletePage && len(msg.Succeeded()) > 0 {
+		// the details of a deleted workflow are gone
+		m.actionReturnPage = temporaltui.WorkflowsPage
+	}
+
+	completed := msg.Completed()
+	if msg.Failed() > 0 && len(msg.Keys) > 1 {
+		// stay to show which workflows failed
+		header, rows := msg.ResultsAsTable()
+		m.getCurrentPageModel().SetHeader(header)
+		m.getCurrentPageModel().SetAllPageData(rows)
+		m.getCurrentPageModel().SetLoading(false)
+		m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: %v", completed.Err), style.ErrorToast)
+		return
+	}
+	if completed.Err == nil {
+		m.pageModels[temporaltui.WorkflowsPage].ClearMarks()
+	}
+	m.completeAction(completed)
+}
+
+// completeAction returns from the page of an action, showing its outcome
+func (m *Model) completeAction(msg temporaltui.ActionCompletedMsg) {
+	if msg.Page == temporaltui.ScheduleDeletePage && msg.Err == nil {
+		// the details of a deleted schedule are gone
+		m.actionReturnPage = temporaltui.SchedulesPage
+	}
+	if msg.Err == nil && msg.Workflow.WorkflowID != "" {
+		m.workflowKey = msg.Workflow
+		m.setPage(temporaltui.WorkflowDetailsPage)
+	} else if msg.Err == nil && msg.BatchJobID != "" {
+		m.batchJobID = msg.BatchJobID
+		m.setPage(temporaltui.BatchOperationPage)
+	} else if msg.Err == nil && msg.ScheduleID != "" {
+		m.scheduleID = msg.ScheduleID
+		m.setPage(temporaltui.ScheduleDetailsPage)
+	} else {
+		m.setPage(m.actionReturnPage)
+	}
+	if msg.Err != nil {
+		m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: %v", msg.Err), style.ErrorToast)
+	} else {
+		m.getCurrentPageModel().SetToast(msg.Message, style.SuccessToast)
+	}
+}
+
+// submitAction runs the action of the current page with the values of its submitted form
+func (m *Model) submitAction(values form.Values) tea.Cmd {
+	m.actionValues = values
+	m.getCurrentPageModel().ClearForm()
+	m.getCurrentPageModel().SetLoadingString(m.currentPage.LoadingString())
+	m.getCurrentPageModel().SetLoading(true)
+	if m.config.ReadOnly && m.currentPage.ChangesServerState() {
+		p := m.currentPage
+		return func() tea.Msg {
+			return temporaltui.ActionCompletedMsg{Page: p, Err: fmt.Errorf("%s is not allowed in read-only mode", p)}
+		}
+	}
+	switch m.currentPage {
+	case temporaltui.WorkflowTermPage:
+		return temporaltui.TerminateWorkflows(m.client, m.actionKeys, values)
+	case temporaltui.WorkflowCancelPage:
+		return temporaltui.CancelWorkflows(m.client, m.actionKeys)
+	case temporaltui.WorkflowSignalPage:
+		return temporaltui.SignalWorkflows(m.client, m.actionKeys, values)
+	case temporaltui.WorkflowDeletePage:
+		return temporaltui.DeleteWorkflows(m.client, m.config.Namespace, m.actionKeys)
+	case temporaltui.WorkflowUpdatePage:
+		return temporaltui.UpdateWorkflow(m.client, m.workflowKey, values)
+	case temporaltui.WorkflowUpdateAttachPage:
+		return temporaltui.AttachUpdate(m.client, m.config.Namespace, values)
+	case temporaltui.ActivityCompletePage:
+		return temporaltui.CompleteActivity(m.client, m.config.Namespace, m.activity, values)
+	case temporaltui.ActivityFailPage:
+		return temporaltui.FailActivity(m.client, m.config.Namespace, m.activity, values)
+	case temporaltui.ActivityHeartbeatPage:
+		return temporaltui.HeartbeatActivity(m.client, m.config.Namespace, m.activity, values)
+	case temporaltui.WorkflowStartPage:
+		return temporaltui.StartWorkflow(m.client, values)
+	case temporaltui.WorkflowSignalWithStartPage:
+		return temporaltui.SignalWithStartWorkflow(m.client, values)
+	case temporaltui.WorkflowResetPage:
+		return temporaltui.ResetWorkflow(m.client, m.config.Namespace, m.resetTarget, values)
+	case temporaltui.WorkflowsQueryPage:
+		return temporaltui.SetWorkflowsQuery(m.client, values["query"])
+	case temporaltui.TaskQueueSelectPage:
+		m.taskQueue = strings.TrimSpace(values["taskQueue"])
+		m.setPage(temporaltui.TaskQueuePage)
+		return m.getCurrentPageCmd()
+	case temporaltui.WorkflowBatchPage:
+		return temporaltui.BatchWorkflows(m.client, m.config.Namespace, m.workflowsQuery, values)
+	case temporaltui.BatchStopPage:
+		return temporaltui.StopBatchOperation(m.client, m.config.Namespace, m.batchJobID, values)
+	case temporaltui.SchedulePausePage:
+		return temporaltui.PauseSchedule(m.client, m.scheduleID, values)
+	case temporaltui.ScheduleUnpausePage:
+		return temporaltui.UnpauseSchedule(m.client, m.scheduleID, values)
+	case temporaltui.ScheduleTriggerPage:
+		return temporaltui.TriggerSchedule(m.client, m.scheduleID, values)
+	case temporaltui.ScheduleBackfillPage:
+		return temporaltui.BackfillSchedule(m.client, m.scheduleID, values)
+	case temporaltui.ScheduleDeletePage:
+		return temporaltui.DeleteSchedule(m.client, m.scheduleID)
+	case temporaltui.ScheduleCreatePage:
+		return temporaltui.CreateSchedule(m.client, values)
+	case temporaltui.ScheduleEditPage:
+		return temporaltui.UpdateSchedule(m.client, m.scheduleID, values)
+	}
+	return nil
+}
+
 func (m *Model) setPage(page temporaltui.Page) {
 	m.getCurrentPageModel().HideToast()
 	m.currentPage = page
@@ -309,22 +907,76 @@ func (m *Model) appendToViewport(content string, startOnNewLine bool) {
 }
 
 func (m *Model) updateKeyHelp() {
-	m.header.KeyHelp = temporaltui.GetPageKeyHelp(m.currentPage, m.currentPageFilterFocused(), m.currentPageFilterApplied(), m.currentPageViewportSaving(), m.getCurrentPageModel().EnteringInput())
+	m.header.KeyHelp = temporaltui.GetPageKeyHelp(m.currentPage, m.currentPageFilterFocused(), m.currentPageFilterApplied(), m.currentPageViewportSaving(), m.getCurrentPageModel().EnteringInput(), m.config.offline(), m.config.ReadOnly)
 }
 
 func (m Model) getCurrentPageCmd() tea.Cmd {
 	switch m.currentPage {
 	case temporaltui.WorkflowsPage:
-		return temporaltui.FetchWorkflowExecutions(m.client)
+		return temporaltui.FetchWorkflowExecutions(m.client, m.workflowsQuery, m.cancelRequestedKeys())
 	case temporaltui.WorkflowDetailsPage:
-		return temporaltui.FetchWorkflowDetails(m.workflowKey, m.client)
-	// case temporaltui.WorkflowTermPage:
-	// return temporaltui.FetchWorkflowDetails(m.workflowKey, m.client)
+		return tea.Batch(temporaltui.FetchWorkflowDetails(m.workflowKey, m.client), temporaltui.FetchCancelRequested(m.client, m.workflowKey))
+	case temporaltui.WorkflowHistoryPage:
+		return temporaltui.FetchWorkflowHistory(m.historySource())
+	case temporaltui.HistoryEventPage:
+		return temporaltui.FetchHistoryEvent(m.historySource(), m.eventID)
+	case temporaltui.HistoryTimelinePage:
+		return temporaltui.FetchHistoryTimeline(m.historySource())
+	case temporaltui.WorkflowDiffPage:
+		return temporaltui.FetchWorkflowDiff(
+			temporaltui.HistorySource{Client: m.client, Key: m.diffKeys[0]},
+			temporaltui.HistorySource{Client: m.client, Key: m.diffKeys[1]},
+		)
+	case temporaltui.WorkflowResetPointsPage:
+		return temporaltui.FetchWorkflowResetPoints(m.workflowKey, m.client)
+	case temporaltui.BatchOperationPage:
+		return temporaltui.FetchBatchOperation(m.client, m.config.Namespace, m.batchJobID)
+	case temporaltui.BatchOperationsPage:
+		return temporaltui.FetchBatchOperations(m.client, m.config.Namespace)
+	case temporaltui.PendingActivitiesPage:
+		return temporaltui.FetchPendingActivities(m.workflowKey, m.client)
+	case temporaltui.WorkflowUpdatesPage:
+		return temporaltui.FetchWorkflowUpdates(m.updates)
+	case temporaltui.SchedulesPage:
+		return temporaltui.FetchSchedules(m.client)
+	case temporaltui.ScheduleDetailsPage:
+		return temporaltui.FetchScheduleDetails(m.client, m.scheduleID)
+	case temporaltui.TaskQueuePage:
+		return temporaltui.FetchTaskQueue(m.client, m.config.Namespace, m.taskQueue)
+	case temporaltui.BuildIDsPage:
+		return temporaltui.FetchBuildIDs(m.client, m.config.Namespace, m.taskQueue)
+	case temporaltui.NamespacesPage:
+		return temporaltui.FetchNamespaces(m.client, m.config.Namespace)
+	case temporaltui.WorkflowUpdateResultPage:
+		return temporaltui.FetchWorkflowUpdateResult(m.updates[m.updateIdx])
+	case temporaltui.WorkflowTermPage, temporaltui.WorkflowCancelPage, temporaltui.WorkflowSignalPage, temporaltui.WorkflowStartPage, temporaltui.WorkflowSignalWithStartPage, temporaltui.WorkflowResetPage,
+		temporaltui.WorkflowsQueryPage, temporaltui.WorkflowBatchPage, temporaltui.BatchStopPage, temporaltui.WorkflowDeletePage, temporaltui.WorkflowUpdatePage,
+		temporaltui.WorkflowUpdateAttachPage, temporaltui.ActivityCompletePage, temporaltui.ActivityFailPage, temporaltui.ActivityHeartbeatPage,
+		temporaltui.SchedulePausePage, temporaltui.ScheduleUnpausePage, temporaltui.ScheduleTriggerPage, temporaltui.ScheduleBackfillPage, temporaltui.ScheduleDeletePage,
+		temporaltui.ScheduleCreatePage, temporaltui.ScheduleEditPage, temporaltui.TaskQueueSelectPage:
+		return nil
 	default:
 		panic("page load command not found")
 	}
 }
 
+// cancelRequestedKeys copies cancelRequested for use outside of Update
+func (m Model) cancelRequestedKeys() map[temporaltui.WorkflowKey]bool {
+	keys := make(map[temporaltui.WorkflowKey]bool, len(m.cancelRequested))
+	for k := range m.cancelRequested {
+		keys[k] = true
+	}
+	return keys
+}
+
+func (m Model) historySource() temporaltui.HistorySource {
+	return temporaltui.HistorySource{
+		Client:   m.client,
+		Key:      m.workflowKey,
+		FilePath: m.config.HistoryFile,
+	}
+}
+
 func (m Model) getPageHeight() int {
 	return m.height - m.header.ViewHeight()
 }
@@ -346,7 +998,44 @@ func (m Model) currentPageViewportSaving() bool {
 }
 
 func (m Model) getFilterPrefix(page temporaltui.Page) string {
-	return page.GetFilterPrefix(m.workflowKey.WorkflowID)
+	workflowID := m.workflowKey.WorkflowID
+	if m.config.offline() {
+		workflowID = filepath.Base(m.config.HistoryFile)
+	}
+	switch page {
+	case temporaltui.WorkflowTermPage, temporaltui.WorkflowCancelPage, temporaltui.WorkflowSignalPage, temporaltui.WorkflowDeletePage:
+		if len(m.actionKeys) > 1 {
+			workflowID = fmt.Sprintf("%d marked workflows", len(m.actionKeys))
+		}
+	}
+	if page == temporaltui.WorkflowsPage && m.workflowsQuery != "" {
+		return fmt.Sprintf("Workflows matching %s", style.Bold.Render(m.workflowsQuery))
+	}
+	if page == temporaltui.WorkflowBatchPage {
+		workflowID = m.workflowsQuery
+	}
+	if page == temporaltui.WorkflowUpdateResultPage && m.updateIdx < len(m.updates) {
+		workflowID = m.updates[m.updateIdx].Key.WorkflowID
+	}
+	if page == temporaltui.BatchOperationPage || page == temporaltui.BatchStopPage {
+		workflowID = m.batchJobID
+	}
+	if page == temporaltui.TaskQueuePage || page == temporaltui.BuildIDsPage {
+		workflowID = m.taskQueue
+	}
+	switch page {
+	case temporaltui.ScheduleDetailsPage, temporaltui.SchedulePausePage, temporaltui.ScheduleUnpausePage,
+		temporaltui.ScheduleTriggerPage, temporaltui.ScheduleBackfillPage, temporaltui.ScheduleDeletePage, temporaltui.ScheduleEditPage:
+		workflowID = m.scheduleID
+	}
+	if page == temporaltui.WorkflowDiffPage && len(m.diffKeys) == 2 {
+		workflowID = fmt.Sprintf("%s and %s", formatDiffKey(m.diffKeys[0]), formatDiffKey(m.diffKeys[1]))
+	}
+	return page.GetFilterPrefix(workflowID, m.eventID)
+}
+
+func formatDiffKey(k temporaltui.WorkflowKey) string {
+	return fmt.Sprintf("%s (%s)", style.Bold.Render(k.WorkflowID), formatter.ShortAllocID(k.RunID))
 }
 
 func getVersionString(v, s string) string {
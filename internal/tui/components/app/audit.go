@@ -0,0 +1,116 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/neomantra/tempted/internal/audit"
+	"github.com/neomantra/tempted/internal/tui/style"
+	"github.com/neomantra/tempted/internal/tui/temporaltui"
+)
+
+// auditEntry describes an operation of the page p on the workflow key
+func (m Model) auditEntry(p temporaltui.Page, key temporaltui.WorkflowKey, err error) audit.Entry {
+	reason := m.actionValues["reason"]
+	switch p {
+	case temporaltui.ActivityFailPage:
+		reason = m.actionValues["message"]
+	case temporaltui.SchedulePausePage, temporaltui.ScheduleUnpausePage:
+		reason = m.actionValues["note"]
+	}
+	return audit.Entry{
+		Time:       time.Now(),
+		User:       m.config.User,
+		Remote:     m.config.Remote,
+		Address:    m.config.HostPort,
+		Namespace:  m.config.Namespace,
+		Operation:  p.String(),
+		WorkflowID: key.WorkflowID,
+		RunID:      key.RunID,
+		Reason:     strings.TrimSpace(reason),
+		Result:     audit.Result(err),
+	}
+}
+
+// auditActionCompleted records the outcome of an action that changes server state
+func (m *Model) auditActionCompleted(msg temporaltui.ActionCompletedMsg) {
+	if !msg.Page.ChangesServerState() {
+		return
+	}
+
+	var entries []audit.Entry
+	switch msg.Page {
+	case temporaltui.WorkflowTermPage, temporaltui.WorkflowCancelPage, temporaltui.WorkflowSignalPage, temporaltui.WorkflowDeletePage:
+		// failed before any workflow was changed, the outcomes of each are in a WorkflowsActionProgressMsg
+		for _, k := range m.actionKeys {
+			entries = append(entries, m.auditEntry(msg.Page, k, msg.Err))
+		}
+	case temporaltui.WorkflowStartPage, temporaltui.WorkflowSignalWithStartPage:
+		key := msg.Workflow
+		if key.WorkflowID == "" {
+			key.WorkflowID = strings.TrimSpace(m.actionValues["id"])
+		}
+		entries = append(entries, m.auditEntry(msg.Page, key, msg.Err))
+	case temporaltui.WorkflowResetPage:
+		entries = append(entries, m.auditEntry(msg.Page, m.resetTarget.Key, msg.Err))
+	case temporaltui.WorkflowBatchPage:
+		entry := m.auditEntry(msg.Page, temporaltui.WorkflowKey{}, msg.Err)
+		entry.Operation = fmt.Sprintf("%s %s", entry.Operation, strings.TrimSpace(m.actionValues["operation"]))
+		entry.Query, entry.BatchJobID = m.workflowsQuery, msg.BatchJobID
+		entries = append(entries, entry)
+	case temporaltui.BatchStopPage:
+		entry := m.auditEntry(msg.Page, temporaltui.WorkflowKey{}, msg.Err)
+		entry.BatchJobID = m.batchJobID
+		entries = append(entries, entry)
+	case temporaltui.ActivityCompletePage, temporaltui.ActivityFailPage, temporaltui.ActivityHeartbeatPage:
+		entry := m.auditEntry(msg.Page, m.activity.Key, msg.Err)
+		entry.ActivityID = m.activity.ActivityID
+		entries = append(entries, entry)
+	case temporaltui.SchedulePausePage, temporaltui.ScheduleUnpausePage, temporaltui.ScheduleDeletePage, temporaltui.ScheduleEditPage:
+		entry := m.auditEntry(msg.Page, temporaltui.WorkflowKey{}, msg.Err)
+		entry.ScheduleID = m.scheduleID
+		entries = append(entries, entry)
+	case temporaltui.ScheduleCreatePage:
+		entry := m.auditEntry(msg.Page, temporaltui.WorkflowKey{}, msg.Err)
+		entry.ScheduleID = strings.TrimSpace(m.actionValues["id"])
+		entries = append(entries, entry)
+	case temporaltui.ScheduleTriggerPage, temporaltui.ScheduleBackfillPage:
+		entry := m.auditEntry(msg.Page, temporaltui.WorkflowKey{}, msg.Err)
+		entry.ScheduleID = m.scheduleID
+		if overlap := strings.TrimSpace(m.actionValues["overlap"]); overlap != "" {
+			entry.Operation = fmt.Sprintf("%s overlap %s", entry.Operation, overlap)
+		}
+		if msg.Page == temporaltui.ScheduleBackfillPage {
+			entry.Operation = fmt.Sprintf("%s from %s to %s", entry.Operation, strings.TrimSpace(m.actionValues["start"]), strings.TrimSpace(m.actionValues["end"]))
+		}
+		entries = append(entries, entry)
+	}
+	m.writeAudit(entries)
+}
+
+// auditWorkflowsAction records the outcome of an action on each of several workflows
+func (m *Model) auditWorkflowsAction(msg temporaltui.WorkflowsActionProgressMsg) {
+	var entries []audit.Entry
+	for i, err := range msg.Errs {
+		entries = append(entries, m.auditEntry(msg.Page, msg.Keys[i], err))
+	}
+	m.writeAudit(entries)
+}
+
+func (m *Model) auditUpdate(u temporaltui.WorkflowUpdate) {
+	_, _, err := u.Outcome()
+	entry := m.auditEntry(temporaltui.WorkflowUpdatePage, u.Key, err)
+	entry.Operation = fmt.Sprintf("%s %s", entry.Operation, u.Name)
+	m.writeAudit([]audit.Entry{entry})
+}
+
+// writeAudit appends entries to the audit log, showing an error if it cannot be written
+func (m *Model) writeAudit(entries []audit.Entry) {
+	for _, entry := range entries {
+		if err := audit.Log(m.config.AuditLog, entry); err != nil {
+			m.getCurrentPageModel().SetToast(fmt.Sprintf("Error: audit log not written: %v", err), style.ErrorToast)
+			return
+		}
+	}
+}
@@ -18,6 +18,10 @@ func nextUpdateID() int {
 	return updateID
 }
 
+func (c Config) offline() bool {
+	return c.HistoryFile != ""
+}
+
 func (c Config) client() (*temporalClient.Client, error) {
 	// opts :=
 	client, err := temporalClient.NewLazyClient(temporalClient.Options{
@@ -0,0 +1,416 @@
+package form
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/neomantra/tempted/internal/dev"
+	"github.com/neomantra/tempted/internal/tui/style"
+)
+
+const multiLineHeight = 8
+
+type Kind int8
+
+const (
+	Text Kind = iota
+	MultiLine
+	Toggle
+)
+
+type Field struct {
+	Key, Label, Placeholder, Value string
+	Kind                           Kind
+	// Suggestions are offered for Text fields, cycling through those matching what was typed
+	Suggestions []string
+}
+
+// Values maps each Field.Key to the value entered. Toggle fields are "true" or "false".
+type Values map[string]string
+
+func (v Values) Bool(key string) bool {
+	b, _ := strconv.ParseBool(v[key])
+	return b
+}
+
+type Config struct {
+	Title  string
+	Info   []string
+	Fields []Field
+	// Validate, if set, is called on submit and the error shown instead of submitting
+	Validate func(Values) error
+	// Preview, if set, is called once the values are valid and its lines shown while confirming
+	Preview func(Values) []string
+	// Confirm asks for confirmation after submitting. If ConfirmText is set, it must be typed to confirm.
+	Confirm     bool
+	ConfirmText string
+}
+
+type field struct {
+	Field
+	textinput textinput.Model
+	textarea  textarea.Model
+	checked   bool
+
+	// suggestPrefix is what was typed before cycling through suggestions, and suggestIdx the current one
+	suggestPrefix string
+	suggestIdx    int
+}
+
+type Model struct {
+	title    string
+	info     []string
+	fields   []field
+	focusIdx int
+	validate func(Values) error
+	preview  func(Values) []string
+	// previewLines are the lines of the preview of the submitted values
+	previewLines []string
+
+	confirm      bool
+	confirmText  string
+	confirming   bool
+	confirmInput textinput.Model
+
+	err    string
+	keyMap formKeyMap
+}
+
+// AddInfo appends lines to the information shown above the fields
+func (m *Model) AddInfo(lines ...string) {
+	m.info = append(m.info, lines...)
+}
+
+func New(c Config) Model {
+	var fields []field
+	for _, f := range c.Fields {
+		newField := field{Field: f, suggestIdx: -1}
+		switch f.Kind {
+		case Text:
+			newField.textinput = textinput.New()
+			newField.textinput.Prompt = "> "
+			newField.textinput.Placeholder = f.Placeholder
+			newField.textinput.SetValue(f.Value)
+		case MultiLine:
+			newField.textarea = textarea.New()
+			newField.textarea.CharLimit = 0
+			newField.textarea.ShowLineNumbers = false
+			newField.textarea.Placeholder = f.Placeholder
+			newField.textarea.SetHeight(multiLineHeight)
+			newField.textarea.SetValue(f.Value)
+		case Toggle:
+			newField.checked, _ = strconv.ParseBool(f.Value)
+		}
+		fields = append(fields, newField)
+	}
+
+	confirmInput := textinput.New()
+	confirmInput.Prompt = "> "
+
+	return Model{
+		title:        c.Title,
+		info:         c.Info,
+		fields:       fields,
+		validate:     c.Validate,
+		preview:      c.Preview,
+		confirm:      c.Confirm,
+		confirmText:  c.ConfirmText,
+		confirming:   len(fields) == 0,
+		confirmInput: confirmInput,
+		keyMap:       GetKeyMap(),
+	}
+}
+
+func (m *Model) Init() tea.Cmd {
+	if m.confirming {
+		if m.confirmText != "" {
+			return m.confirmInput.Focus()
+		}
+		return nil
+	}
+	return m.focus(0)
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	dev.Debug(fmt.Sprintf("form %T", msg))
+	var cmd tea.Cmd
+
+	if m.confirming {
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			switch {
+			case key.Matches(msg, m.keyMap.Cancel):
+				if len(m.fields) == 0 {
+					return m, cancelled
+				}
+				m.confirming = false
+				m.err = ""
+				m.confirmInput.Blur()
+				m.confirmInput.Reset()
+				return m, m.focus(m.focusIdx)
+
+			case m.confirmText == "" && key.Matches(msg, m.keyMap.Confirm):
+				return m, m.submitted()
+
+			case m.confirmText != "" && key.Matches(msg, m.keyMap.Enter):
+				if m.confirmInput.Value() != m.confirmText {
+					m.err = "confirmation does not match"
+					return m, nil
+				}
+				return m, m.submitted()
+			}
+		}
+
+		if m.confirmText != "" {
+			m.confirmInput, cmd = m.confirmInput.Update(msg)
+		}
+		return m, cmd
+	}
+
+	current := &m.fields[m.focusIdx]
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keyMap.Cancel):
+			return m, cancelled
+
+		case key.Matches(msg, m.keyMap.Next):
+			return m, m.focus(m.focusIdx + 1)
+
+		case key.Matches(msg, m.keyMap.Prev):
+			return m, m.focus(m.focusIdx - 1)
+
+		case key.Matches(msg, m.keyMap.Submit):
+			return m, m.submit()
+
+		case key.Matches(msg, m.keyMap.Enter) && current.Kind != MultiLine:
+			if m.focusIdx == len(m.fields)-1 {
+				return m, m.submit()
+			}
+			return m, m.focus(m.focusIdx + 1)
+
+		case key.Matches(msg, m.keyMap.Toggle) && current.Kind == Toggle:
+			current.checked = !current.checked
+			return m, nil
+
+		case key.Matches(msg, m.keyMap.Suggest) && current.Kind == Text:
+			current.nextSuggestion()
+			return m, nil
+		}
+		current.suggestIdx = -1
+	}
+
+	switch current.Kind {
+	case Text:
+		current.textinput, cmd = current.textinput.Update(msg)
+	case MultiLine:
+		current.textarea, cmd = current.textarea.Update(msg)
+	}
+	return m, cmd
+}
+
+func (m Model) View() string {
+	var lines []string
+	if m.title != "" {
+		lines = append(lines, style.Bold.Render(m.title))
+	}
+	lines = append(lines, m.info...)
+	lines = append(lines, "")
+
+	labelWidth := m.labelWidth()
+	for idx, f := range m.fields {
+		labelStyle := style.FormLabel
+		if idx == m.focusIdx && !m.confirming {
+			labelStyle = style.FormLabelFocused
+		}
+		label := labelStyle.Copy().Width(labelWidth).Render(f.Label)
+
+		var value string
+		switch {
+		case m.confirming:
+			value = f.value()
+		case f.Kind == Text:
+			value = f.textinput.View()
+		case f.Kind == MultiLine:
+			value = f.textarea.View()
+		case f.Kind == Toggle:
+			value = "[ ]"
+			if f.checked {
+				value = "[x]"
+			}
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top, label, value))
+		if idx == m.focusIdx && !m.confirming && len(f.Suggestions) > 0 {
+			suggestions := fmt.Sprintf("suggestions (%s): %s", m.keyMap.Suggest.Help().Key, strings.Join(f.Suggestions, ", "))
+			lines = append(lines, strings.Repeat(" ", labelWidth)+style.FormLabel.Render(suggestions))
+		}
+	}
+
+	if m.confirming {
+		if len(m.previewLines) > 0 {
+			lines = append(lines, "")
+			lines = append(lines, m.previewLines...)
+		}
+		lines = append(lines, "")
+		if m.confirmText != "" {
+			lines = append(lines, style.FormConfirm.Render(fmt.Sprintf("Type %q to confirm, esc to go back", m.confirmText)))
+			lines = append(lines, m.confirmInput.View())
+		} else {
+			lines = append(lines, style.FormConfirm.Render("Press y to confirm, esc to go back"))
+		}
+	}
+
+	if m.err != "" {
+		lines = append(lines, "", style.FormError.Render("Error: "+m.err))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (m *Model) SetWidth(width int) {
+	inputWidth := width - m.labelWidth() - 4
+	if inputWidth < 10 {
+		inputWidth = 10
+	}
+	for idx := range m.fields {
+		switch m.fields[idx].Kind {
+		case Text:
+			m.fields[idx].textinput.Width = inputWidth
+		case MultiLine:
+			m.fields[idx].textarea.SetWidth(inputWidth)
+		}
+	}
+	m.confirmInput.Width = inputWidth
+}
+
+// SetSuggestions replaces the suggestions of the field with the given key
+func (m *Model) SetSuggestions(key string, suggestions []string) {
+	for idx := range m.fields {
+		if m.fields[idx].Key == key {
+			m.fields[idx].Suggestions = suggestions
+			m.fields[idx].suggestIdx = -1
+		}
+	}
+}
+
+func (m Model) Confirming() bool {
+	return m.confirming
+}
+
+func (m Model) Values() Values {
+	values := make(Values)
+	for _, f := range m.fields {
+		values[f.Key] = f.value()
+	}
+	return values
+}
+
+func (m *Model) focus(idx int) tea.Cmd {
+	if len(m.fields) == 0 {
+		return nil
+	}
+	idx = (idx + len(m.fields)) % len(m.fields)
+	m.blur()
+	m.focusIdx = idx
+	switch m.fields[idx].Kind {
+	case Text:
+		return m.fields[idx].textinput.Focus()
+	case MultiLine:
+		return m.fields[idx].textarea.Focus()
+	}
+	return nil
+}
+
+func (m *Model) blur() {
+	for i := range m.fields {
+		switch m.fields[i].Kind {
+		case Text:
+			m.fields[i].textinput.Blur()
+		case MultiLine:
+			m.fields[i].textarea.Blur()
+		}
+	}
+}
+
+func (m *Model) submit() tea.Cmd {
+	if m.validate != nil {
+		if err := m.validate(m.Values()); err != nil {
+			m.err = err.Error()
+			return nil
+		}
+	}
+	m.err = ""
+	if !m.confirm {
+		return m.submitted()
+	}
+
+	if m.preview != nil {
+		m.previewLines = m.preview(m.Values())
+	}
+
+	m.confirming = true
+	m.blur()
+	if m.confirmText != "" {
+		return m.confirmInput.Focus()
+	}
+	return nil
+}
+
+func (m Model) submitted() tea.Cmd {
+	values := m.Values()
+	return func() tea.Msg { return SubmittedMsg{Values: values} }
+}
+
+func (m Model) labelWidth() int {
+	width := 0
+	for _, f := range m.fields {
+		if w := lipgloss.Width(f.Label); w > width {
+			width = w
+		}
+	}
+	return width + 2
+}
+
+// nextSuggestion fills in the next suggestion that starts with what was typed before cycling began
+func (f *field) nextSuggestion() {
+	if f.suggestIdx < 0 {
+		f.suggestPrefix = f.textinput.Value()
+	}
+	for i := 1; i <= len(f.Suggestions); i++ {
+		idx := (f.suggestIdx + i) % len(f.Suggestions)
+		if strings.HasPrefix(f.Suggestions[idx], f.suggestPrefix) {
+			f.suggestIdx = idx
+			f.textinput.SetValue(f.Suggestions[idx])
+			f.textinput.CursorEnd()
+			return
+		}
+	}
+}
+
+func (f field) value() string {
+	switch f.Kind {
+	case Text:
+		return f.textinput.Value()
+	case MultiLine:
+		return f.textarea.Value()
+	case Toggle:
+		return strconv.FormatBool(f.checked)
+	}
+	return ""
+}
+
+// Msg and Cmds
+
+type SubmittedMsg struct {
+	Values Values
+}
+
+type CancelledMsg struct{}
+
+func cancelled() tea.Msg {
+	return CancelledMsg{}
+}
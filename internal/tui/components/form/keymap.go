@@ -0,0 +1,51 @@
+package form
+
+import "github.com/charmbracelet/bubbles/key"
+
+type formKeyMap struct {
+	Next    key.Binding
+	Prev    key.Binding
+	Enter   key.Binding
+	Submit  key.Binding
+	Toggle  key.Binding
+	Suggest key.Binding
+	Cancel  key.Binding
+	Confirm key.Binding
+}
+
+func GetKeyMap() formKeyMap {
+	return formKeyMap{
+		Next: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "next field"),
+		),
+		Prev: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "prev field"),
+		),
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "next/submit"),
+		),
+		Submit: key.NewBinding(
+			key.WithKeys("ctrl+s"),
+			key.WithHelp("ctrl+s", "submit"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "toggle"),
+		),
+		Suggest: key.NewBinding(
+			key.WithKeys("ctrl+n"),
+			key.WithHelp("ctrl+n", "next suggestion"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+		Confirm: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "confirm"),
+		),
+	}
+}
@@ -9,6 +9,10 @@ import (
 
 type Model struct {
 	logo, logoColor, nomadUrl, version, KeyHelp string
+	// Namespace, if set, is the namespace in use, shown with the url
+	Namespace string
+	// Badge, if set, is shown prominently under the url, e.g. READ-ONLY
+	Badge string
 }
 
 func New(logo string, logoColor string, nomadUrl, version, keyHelp string) (m Model) {
@@ -21,8 +25,16 @@ func (m Model) View() string {
 		logoStyle.Foreground(lipgloss.Color(m.logoColor))
 	}
 	logo := logoStyle.Render(m.logo)
-	clusterUrl := style.ClusterUrl.Render(m.nomadUrl)
-	left := style.Header.Render(lipgloss.JoinVertical(lipgloss.Center, logo, m.version, clusterUrl))
+	url := m.nomadUrl
+	if m.Namespace != "" {
+		url += " | " + m.Namespace
+	}
+	clusterUrl := style.ClusterUrl.Render(url)
+	lines := []string{logo, m.version, clusterUrl}
+	if m.Badge != "" {
+		lines = append(lines, style.HeaderBadge.Render(m.Badge))
+	}
+	left := style.Header.Render(lipgloss.JoinVertical(lipgloss.Center, lines...))
 	styledKeyHelp := style.KeyHelp.Render(m.KeyHelp)
 	return lipgloss.JoinHorizontal(lipgloss.Center, left, styledKeyHelp)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/neomantra/tempted/internal/dev"
 	"github.com/neomantra/tempted/internal/tui/components/filter"
+	"github.com/neomantra/tempted/internal/tui/components/form"
 	"github.com/neomantra/tempted/internal/tui/components/toast"
 	"github.com/neomantra/tempted/internal/tui/components/viewport"
 	"github.com/neomantra/tempted/internal/tui/constants"
@@ -23,6 +24,8 @@ type Config struct {
 	FilterPrefix, LoadingString                            string
 	CopySavePath, SelectionEnabled, WrapText, RequestInput bool
 	ViewportConditionalStyle                               map[string]lipgloss.Style
+	// MarkingEnabled allows marking several rows, by Row.Key, for actions that apply to all of them
+	MarkingEnabled bool
 }
 
 type Model struct {
@@ -43,6 +46,12 @@ type Model struct {
 	needsNewInput    bool
 	inputPrefix      string
 	initialized      bool
+
+	form       form.Model
+	formActive bool
+
+	markingEnabled bool
+	marks          map[string]bool
 }
 
 func New(c Config) Model {
@@ -73,6 +82,8 @@ func New(c Config) Model {
 		doesRequestInput: c.RequestInput,
 		textinput:        pageTextInput,
 		needsNewInput:    needsNewInput,
+		markingEnabled:   c.MarkingEnabled,
+		marks:            make(map[string]bool),
 	}
 	return model
 }
@@ -84,6 +95,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		cmds []tea.Cmd
 	)
 
+	if m.formActive {
+		m.form, cmd = m.form.Update(msg)
+		return m, cmd
+	}
+
 	if m.EnteringInput() {
 		if !m.initialized {
 			m.initialized = true
@@ -142,6 +158,30 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			case key.Matches(msg, keymap.KeyMap.Filter):
 				m.filter.Focus()
 				return m, textinput.Blink
+
+			case m.markingEnabled && key.Matches(msg, keymap.KeyMap.Mark):
+				// space is also page down in the viewport, so don't pass it on
+				if row, err := m.GetSelectedPageRow(); err == nil {
+					m.toggleMark(row.Key)
+					m.updateViewport()
+				}
+				return m, nil
+
+			case m.markingEnabled && key.Matches(msg, keymap.KeyMap.MarkAll):
+				for _, row := range m.pageData.Filtered {
+					if row.Key != "" {
+						m.marks[row.Key] = true
+					}
+				}
+				m.updateViewport()
+				return m, nil
+
+			case m.markingEnabled && key.Matches(msg, keymap.KeyMap.Invert):
+				for _, row := range m.pageData.Filtered {
+					m.toggleMark(row.Key)
+				}
+				m.updateViewport()
+				return m, nil
 			}
 
 			m.viewport, cmd = m.viewport.Update(msg)
@@ -168,7 +208,9 @@ func (m Model) View() string {
 	if m.loading {
 		content = fmt.Sprintf(m.loadingString)
 	} else {
-		if m.EnteringInput() {
+		if m.formActive {
+			content = m.form.View()
+		} else if m.EnteringInput() {
 			content = m.inputPrefix + m.textinput.View()
 		} else {
 			content = m.viewport.View()
@@ -180,6 +222,7 @@ func (m Model) View() string {
 func (m *Model) SetWindowSize(width, height int) {
 	m.width, m.height = width, height
 	m.viewport.SetSize(width, height-m.filter.ViewHeight())
+	m.form.SetWidth(width)
 }
 
 func (m *Model) SetHeader(header []string) {
@@ -195,6 +238,10 @@ func (m *Model) SetViewportStyle(headerStyle, contentStyle lipgloss.Style) {
 	m.viewport.ContentStyle = contentStyle
 }
 
+func (m *Model) SetLoadingString(s string) {
+	m.loadingString = s
+}
+
 func (m *Model) SetLoading(isLoading bool) {
 	m.loading = isLoading
 }
@@ -224,6 +271,10 @@ func (m *Model) HideToast() {
 	m.viewport.HideToast()
 }
 
+func (m *Model) SetToast(message string, messageStyle lipgloss.Style) {
+	m.viewport.SetToast(message, messageStyle)
+}
+
 func (m *Model) AppendToViewport(rows []Row, startOnNewLine bool) {
 	newPageData := m.pageData.All
 	for i, r := range rows {
@@ -244,6 +295,49 @@ func (m *Model) AppendToViewport(rows []Row, startOnNewLine bool) {
 	m.SetAllPageData(newPageData)
 }
 
+// SetForm shows f in place of the viewport until ClearForm is called
+func (m *Model) SetForm(f form.Model) tea.Cmd {
+	m.form = f
+	m.form.SetWidth(m.width)
+	m.formActive = true
+	return m.form.Init()
+}
+
+func (m *Model) ClearForm() {
+	m.formActive = false
+}
+
+func (m *Model) SetFormSuggestions(key string, suggestions []string) {
+	m.form.SetSuggestions(key, suggestions)
+}
+
+// MarkedRows returns the marked rows in the order they appear on the page, including any filtered out
+func (m Model) MarkedRows() []Row {
+	var marked []Row
+	for _, row := range m.pageData.All {
+		if m.marks[row.Key] {
+			marked = append(marked, row)
+		}
+	}
+	return marked
+}
+
+// HiddenMarkCount counts the marked rows filtered out of view
+func (m Model) HiddenMarkCount() int {
+	visible := 0
+	for _, row := range m.pageData.Filtered {
+		if m.marks[row.Key] {
+			visible++
+		}
+	}
+	return len(m.MarkedRows()) - visible
+}
+
+func (m *Model) ClearMarks() {
+	m.marks = make(map[string]bool)
+	m.updateViewport()
+}
+
 func (m *Model) SetDoesNeedNewInput() {
 	if !m.doesRequestInput {
 		return
@@ -286,7 +380,11 @@ func (m Model) ViewportSelectionAtBottom() bool {
 }
 
 func (m Model) EnteringInput() bool {
-	return m.doesRequestInput && m.needsNewInput
+	return m.formActive || (m.doesRequestInput && m.needsNewInput)
+}
+
+func (m Model) FormActive() bool {
+	return m.formActive
 }
 
 func (m Model) FilterFocused() bool {
@@ -314,6 +412,25 @@ func (m *Model) updateViewport() {
 	m.viewport.SetStringToHighlight(m.filter.Value())
 	m.updateFilteredData()
 	m.viewport.SetContent(rowsToStrings(m.pageData.Filtered))
+
+	markedIdxs := make(map[int]bool)
+	for idx, row := range m.pageData.Filtered {
+		if m.marks[row.Key] {
+			markedIdxs[idx] = true
+		}
+	}
+	m.viewport.SetMarkedContentIdxs(markedIdxs, m.HiddenMarkCount())
+}
+
+func (m *Model) toggleMark(key string) {
+	if key == "" {
+		return
+	}
+	if m.marks[key] {
+		delete(m.marks, key)
+	} else {
+		m.marks[key] = true
+	}
 }
 
 func (m *Model) updateFilteredData() {
@@ -44,6 +44,11 @@ type Model struct {
 	selectionEnabled   bool
 	wrapText           bool
 
+	// markedContentIdxs are the indexes of content marked for an action that applies to several items
+	markedContentIdxs map[int]bool
+	// hiddenMarks counts the marked items not in content, e.g. filtered out
+	hiddenMarks int
+
 	// width is the width of the entire viewport in terminal columns
 	width int
 	// height is the height of the entire viewport in terminal rows
@@ -67,6 +72,7 @@ type Model struct {
 
 	HeaderStyle          lipgloss.Style
 	SelectedContentStyle lipgloss.Style
+	MarkedContentStyle   lipgloss.Style
 	HighlightStyle       lipgloss.Style
 	ContentStyle         lipgloss.Style
 	FooterStyle          lipgloss.Style
@@ -90,6 +96,7 @@ func New(width, height int) (m Model) {
 
 	m.HeaderStyle = style.ViewportHeaderStyle
 	m.SelectedContentStyle = style.ViewportSelectedRowStyle
+	m.MarkedContentStyle = style.ViewportMarkedRowStyle
 	m.HighlightStyle = style.ViewportHighlightStyle
 	m.FooterStyle = style.ViewportFooterStyle
 	return m
@@ -240,6 +247,9 @@ func (m Model) View() string {
 				lineStyle = v
 			}
 		}
+		if m.markedContentIdxs[contentIdx] {
+			lineStyle = m.MarkedContentStyle
+		}
 		if isSelected {
 			lineStyle = m.SelectedContentStyle
 		}
@@ -298,6 +308,12 @@ func (m *Model) HideToast() {
 	m.toast.Visible = false
 }
 
+// SetToast shows message in a toast at the bottom of the viewport until it times out
+func (m *Model) SetToast(message string, messageStyle lipgloss.Style) {
+	m.toast = toast.New(message)
+	m.toast.MessageStyle = messageStyle.Copy().Width(m.width)
+}
+
 // SetSize sets the viewport's width and height, including header.
 func (m *Model) SetSize(width, height int) {
 	m.setWidthAndHeight(width, height)
@@ -318,6 +334,12 @@ func (m *Model) SetContent(content []string) {
 	m.fixSelection()
 }
 
+// SetMarkedContentIdxs sets which items of content are shown as marked, and how many marked items are hidden
+func (m *Model) SetMarkedContentIdxs(idxs map[int]bool, hidden int) {
+	m.markedContentIdxs = idxs
+	m.hiddenMarks = hidden
+}
+
 // SetSelectedContentIdx sets the selectedContentIdx with bounds. Adjusts yOffset as necessary.
 func (m *Model) SetSelectedContentIdx(n int) {
 	if m.contentHeight == 0 {
@@ -635,9 +657,19 @@ func (m Model) getFooter() (string, int) {
 		denominator = totalNumLines
 	}
 
-	if totalNumLines >= m.height-len(m.getHeader()) {
-		percentScrolled := percent(numerator, denominator)
-		footerString := fmt.Sprintf("%d%% (%d/%d)", percentScrolled, numerator, denominator)
+	overflowing := totalNumLines >= m.height-len(m.getHeader())
+	marks := len(m.markedContentIdxs) + m.hiddenMarks
+	if overflowing || marks > 0 {
+		var footerString string
+		if overflowing {
+			footerString = fmt.Sprintf("%d%% (%d/%d)", percent(numerator, denominator), numerator, denominator)
+		}
+		if marks > 0 {
+			footerString = strings.TrimLeft(fmt.Sprintf("%s  %d marked", footerString, marks), " ")
+		}
+		if m.hiddenMarks > 0 {
+			footerString += fmt.Sprintf(", %d hidden", m.hiddenMarks)
+		}
 		renderedFooterString := m.FooterStyle.Copy().MaxWidth(m.width).Render(footerString)
 		footerHeight := lipgloss.Height(renderedFooterString)
 		return renderedFooterString, footerHeight
@@ -24,10 +24,37 @@ const TablePadding = "    "
 var JobsViewportConditionalStyle = map[string]lipgloss.Style{
 	TablePadding + "pending" + TablePadding: style.JobRowPending,
 	TablePadding + "dead" + TablePadding:    style.JobRowDead,
+	TablePadding + "cancel requested":       style.JobRowPending,
 }
 
 var AllocationsViewportConditionalStyle = JobsViewportConditionalStyle
 
+var BatchOperationsViewportConditionalStyle = map[string]lipgloss.Style{
+	TablePadding + "Running" + TablePadding: style.JobRowPending,
+	TablePadding + "Failed" + TablePadding:  style.JobRowDead,
+}
+
+var SchedulesViewportConditionalStyle = map[string]lipgloss.Style{
+	TablePadding + "Paused" + TablePadding: style.JobRowPending,
+}
+
+var BuildIDsViewportConditionalStyle = map[string]lipgloss.Style{
+	TablePadding + "default" + TablePadding: style.DefaultBuildIDRow,
+}
+
+var NamespacesViewportConditionalStyle = map[string]lipgloss.Style{
+	TablePadding + "active" + TablePadding: style.ActiveNamespaceRow,
+}
+
+const DiffAttributePrefix = "      ~ "
+
+var DiffViewportConditionalStyle = map[string]lipgloss.Style{
+	TablePadding + "differs":    style.DiffRowChanged,
+	TablePadding + "left only":  style.DiffRowLeftOnly,
+	TablePadding + "right only": style.DiffRowRightOnly,
+	DiffAttributePrefix:         style.DiffRowChanged,
+}
+
 const DefaultPageInput = "/bin/sh"
 
 const DefaultEventJQQuery = `.Events[] | {
@@ -5,22 +5,99 @@ import (
 )
 
 type keyMap struct {
-	Back    key.Binding
-	Exec    key.Binding
-	Exit    key.Binding
-	Filter  key.Binding
-	Forward key.Binding
-	Reload  key.Binding
-	Task    key.Binding
-	Term    key.Binding
-	Wrap    key.Binding
+	Activities key.Binding
+	Attach     key.Binding
+	Back       key.Binding
+	Backfill   key.Binding
+	Batch      key.Binding
+	Batches    key.Binding
+	Cancel     key.Binding
+	Complete   key.Binding
+	Create     key.Binding
+	Delete     key.Binding
+	Diff       key.Binding
+	Edit       key.Binding
+	Exec       key.Binding
+	Exit       key.Binding
+	Fail       key.Binding
+	Filter     key.Binding
+	Forward    key.Binding
+	Heartbeat  key.Binding
+	Invert     key.Binding
+	Mark       key.Binding
+	MarkAll    key.Binding
+	Namespaces key.Binding
+	Pause      key.Binding
+	Query      key.Binding
+	Queue      key.Binding
+	Reload     key.Binding
+	Rerun      key.Binding
+	Reset      key.Binding
+	Running    key.Binding
+	Schedules  key.Binding
+	Signal     key.Binding
+	SigStart   key.Binding
+	Start      key.Binding
+	Stop       key.Binding
+	Task       key.Binding
+	Term       key.Binding
+	Timeline   key.Binding
+	Trigger    key.Binding
+	Update     key.Binding
+	Updates    key.Binding
+	Versions   key.Binding
+	Wrap       key.Binding
 }
 
 var KeyMap = keyMap{
+	Activities: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "pending activities"),
+	),
+	Attach: key.NewBinding(
+		key.WithKeys("A"),
+		key.WithHelp("A", "re-attach update"),
+	),
 	Back: key.NewBinding(
 		key.WithKeys("esc"),
 		key.WithHelp("esc", "back"),
 	),
+	Backfill: key.NewBinding(
+		key.WithKeys("F"),
+		key.WithHelp("F", "backfill"),
+	),
+	Batch: key.NewBinding(
+		key.WithKeys("X"),
+		key.WithHelp("X", "batch query"),
+	),
+	Batches: key.NewBinding(
+		key.WithKeys("B"),
+		key.WithHelp("B", "batches"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "cancel"),
+	),
+	Complete: key.NewBinding(
+		key.WithKeys("C"),
+		key.WithHelp("C", "complete"),
+	),
+	Create: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "new schedule"),
+	),
+	Delete: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "delete"),
+	),
+	Diff: key.NewBinding(
+		key.WithKeys("="),
+		key.WithHelp("=", "diff marked"),
+	),
+	Edit: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "edit"),
+	),
 	Exec: key.NewBinding(
 		key.WithKeys("e"),
 		key.WithHelp("e", "exec"),
@@ -29,6 +106,10 @@ var KeyMap = keyMap{
 		key.WithKeys("q", "ctrl+c"),
 		key.WithHelp("q/ctrl+c", "exit"),
 	),
+	Fail: key.NewBinding(
+		key.WithKeys("F"),
+		key.WithHelp("F", "fail"),
+	),
 	Filter: key.NewBinding(
 		key.WithKeys("/"),
 		key.WithHelp("/", "filter"),
@@ -37,14 +118,102 @@ var KeyMap = keyMap{
 		key.WithKeys("enter"),
 		key.WithHelp("enter", "enter"),
 	),
+	Heartbeat: key.NewBinding(
+		key.WithKeys("H"),
+		key.WithHelp("H", "heartbeat"),
+	),
+	Invert: key.NewBinding(
+		key.WithKeys("~"),
+		key.WithHelp("~", "invert marks"),
+	),
+	Mark: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "mark"),
+	),
+	MarkAll: key.NewBinding(
+		key.WithKeys("*"),
+		key.WithHelp("*", "mark all"),
+	),
+	Namespaces: key.NewBinding(
+		key.WithKeys("N"),
+		key.WithHelp("N", "namespaces"),
+	),
+	Pause: key.NewBinding(
+		key.WithKeys("P"),
+		key.WithHelp("P", "pause/unpause"),
+	),
+	Query: key.NewBinding(
+		key.WithKeys("V"),
+		key.WithHelp("V", "query"),
+	),
+	Queue: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "workflow's task queue"),
+	),
 	Reload: key.NewBinding(
 		key.WithKeys("r"),
 		key.WithHelp("r", "reload"),
 	),
+	Rerun: key.NewBinding(
+		key.WithKeys("E"),
+		key.WithHelp("E", "re-run"),
+	),
+	Reset: key.NewBinding(
+		key.WithKeys("R"),
+		key.WithHelp("R", "reset"),
+	),
+	Running: key.NewBinding(
+		key.WithKeys("W"),
+		key.WithHelp("W", "running workflows"),
+	),
+	Schedules: key.NewBinding(
+		key.WithKeys("C"),
+		key.WithHelp("C", "schedules"),
+	),
+	Signal: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "signal"),
+	),
+	SigStart: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "signal with start"),
+	),
+	Start: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "start new"),
+	),
+	Stop: key.NewBinding(
+		key.WithKeys("K"),
+		key.WithHelp("K", "stop batch"),
+	),
+	Task: key.NewBinding(
+		key.WithKeys("Q"),
+		key.WithHelp("Q", "task queue"),
+	),
 	Term: key.NewBinding(
 		key.WithKeys("t"),
 		key.WithHelp("t", "term"),
 	),
+	Timeline: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "timeline"),
+	),
+	Trigger: key.NewBinding(
+		key.WithKeys("T"),
+		key.WithHelp("T", "trigger"),
+	),
+	Update: key.NewBinding(
+		key.WithKeys("U"),
+		key.WithHelp("U", "update"),
+	),
+	Updates: key.NewBinding(
+		key.WithKeys("O"),
+		key.WithHelp("O", "updates"),
+	),
+	Versions: key.NewBinding(
+		key.WithKeys("V"),
+		key.WithHelp("V", "build IDs"),
+	),
 	Wrap: key.NewBinding(
 		key.WithKeys("ctrl+w"),
 		key.WithHelp("ctrl+w", "toggle wrap"),
@@ -19,6 +19,7 @@ var (
 	Bold                       = Regular.Copy().Bold(true)
 	Logo                       = Regular.Copy().Padding(0, 1).Foreground(yellow)
 	ClusterUrl                 = Bold.Copy()
+	HeaderBadge                = Bold.Copy().Padding(0, 1).Foreground(black).Background(darkred)
 	KeyHelp                    = Regular.Copy().Padding(0, 2)
 	KeyHelpKey                 = Regular.Copy().Foreground(blue).Bold(true)
 	KeyHelpDescription         = Regular.Copy()
@@ -28,17 +29,27 @@ var (
 	FilterApplied              = Regular.Copy().Foreground(black).Background(greenblue)
 	JobRowPending              = Regular.Copy().Foreground(yellow)
 	JobRowDead                 = Regular.Copy().Foreground(red)
+	DefaultBuildIDRow          = Bold.Copy().Foreground(darkgreen)
+	ActiveNamespaceRow         = Bold.Copy().Foreground(darkgreen)
+	DiffRowChanged             = Regular.Copy().Foreground(yellow)
+	DiffRowLeftOnly            = Regular.Copy().Foreground(red)
+	DiffRowRightOnly           = Regular.Copy().Foreground(darkgreen)
 	PseudoPrompt               = Regular.Copy().Background(blue)
 	Viewport                   = Regular.Copy()
 	ViewportHeaderStyle        = Bold.Copy()
 	ViewportSelectedRowStyle   = Regular.Copy().Foreground(black).Background(blue)
 	ViewportHighlightStyle     = Regular.Copy().Foreground(black).Background(pink)
+	ViewportMarkedRowStyle     = Bold.Copy().Foreground(pink)
 	ViewportFooterStyle        = Regular.Copy().Foreground(grey)
 	SaveDialogPromptStyle      = Regular.Copy().Background(darkred).Foreground(black)
 	SaveDialogPlaceholderStyle = Regular.Copy().Background(darkred).Foreground(black)
 	SaveDialogTextStyle        = Regular.Copy().Background(darkred).Foreground(black)
 	StdOut                     = Regular.Copy().UnsetForeground()
 	StdErr                     = Regular.Copy().Foreground(red)
+	FormLabel                  = Regular.Copy().PaddingLeft(1)
+	FormLabelFocused           = Bold.Copy().PaddingLeft(1).Foreground(blue)
+	FormConfirm                = Bold.Copy().Foreground(yellow)
+	FormError                  = Bold.Copy().Foreground(red)
 	SuccessToast               = Bold.Copy().PaddingLeft(1).Foreground(black).Background(darkgreen)
 	ErrorToast                 = Bold.Copy().PaddingLeft(1).Foreground(black).Background(darkred)
 )
@@ -0,0 +1,129 @@
+package temporaltui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+)
+
+// maxWorkflowKeysInfo is the number of workflows listed in the form of an action on several workflows
+const maxWorkflowKeysInfo = 5
+
+// workflowAction changes the state of a single workflow
+type workflowAction func(ctx context.Context, key WorkflowKey) error
+
+// WorkflowsActionProgressMsg reports the outcome of an action on each of several workflows so far.
+// The action is applied to one workflow at a time so that progress can be shown.
+type WorkflowsActionProgressMsg struct {
+	Page Page
+	Keys []WorkflowKey
+	// Errs holds the outcome of the action on Keys[i] for each workflow done so far
+	Errs []error
+
+	verb   string
+	action workflowAction
+}
+
+func runWorkflowsAction(p Page, verb string, keys []WorkflowKey, action workflowAction) tea.Cmd {
+	return WorkflowsActionProgressMsg{Page: p, Keys: keys, verb: verb, action: action}.Next()
+}
+
+// Next applies the action to the next workflow
+func (msg WorkflowsActionProgressMsg) Next() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		done := len(msg.Errs)
+		err := msg.action(ctx, msg.Keys[done])
+		// copy so earlier messages are unaffected
+		msg.Errs = append(msg.Errs[:done:done], err)
+		return msg
+	}
+}
+
+func (msg WorkflowsActionProgressMsg) Done() bool {
+	return len(msg.Errs) == len(msg.Keys)
+}
+
+func (msg WorkflowsActionProgressMsg) Progress() string {
+	return fmt.Sprintf("%d/%d", len(msg.Errs), len(msg.Keys))
+}
+
+// Succeeded returns the workflows the action succeeded on so far
+func (msg WorkflowsActionProgressMsg) Succeeded() []WorkflowKey {
+	var keys []WorkflowKey
+	for i, err := range msg.Errs {
+		if err == nil {
+			keys = append(keys, msg.Keys[i])
+		}
+	}
+	return keys
+}
+
+func (msg WorkflowsActionProgressMsg) Failed() int {
+	return len(msg.Errs) - len(msg.Succeeded())
+}
+
+// Completed summarizes the outcome of the action once Done
+func (msg WorkflowsActionProgressMsg) Completed() ActionCompletedMsg {
+	if len(msg.Keys) == 1 {
+		if msg.Errs[0] != nil {
+			return ActionCompletedMsg{Page: msg.Page, Err: msg.Errs[0]}
+		}
+		return ActionCompletedMsg{Page: msg.Page, Message: fmt.Sprintf("%s %s", msg.verb, msg.Keys[0].WorkflowID)}
+	}
+
+	if failed := msg.Failed(); failed > 0 {
+		return ActionCompletedMsg{Page: msg.Page, Err: fmt.Errorf("%d of %d workflows failed", failed, len(msg.Keys))}
+	}
+	return ActionCompletedMsg{Page: msg.Page, Message: fmt.Sprintf("%s %d workflows", msg.verb, len(msg.Keys))}
+}
+
+// ResultsAsTable lists the outcome of the action on each workflow
+func (msg WorkflowsActionProgressMsg) ResultsAsTable() ([]string, []page.Row) {
+	var resultRows [][]string
+	for i, err := range msg.Errs {
+		result := "ok"
+		if err != nil {
+			result = "error: " + strings.ReplaceAll(err.Error(), "\n", " ")
+		}
+		resultRows = append(resultRows, []string{msg.Keys[i].WorkflowID, msg.Keys[i].RunID, result})
+	}
+
+	columns := []string{"Workflow ID", "Run ID", "Result"}
+	table := formatter.GetRenderedTableAsString(columns, resultRows)
+
+	var rows []page.Row
+	for _, row := range table.ContentRows {
+		rows = append(rows, page.Row{Key: "", Row: row})
+	}
+	return table.HeaderRows, rows
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+// workflowKeysInfo describes the workflows an action form applies to
+func workflowKeysInfo(keys []WorkflowKey) []string {
+	if len(keys) == 1 {
+		return []string{
+			fmt.Sprintf("Workflow ID: %s", keys[0].WorkflowID),
+			fmt.Sprintf("Run ID:      %s", keys[0].RunID),
+		}
+	}
+
+	info := []string{fmt.Sprintf("%d marked workflows:", len(keys))}
+	for i, key := range keys {
+		if i == maxWorkflowKeysInfo {
+			info = append(info, fmt.Sprintf("  ...and %d more", len(keys)-maxWorkflowKeysInfo))
+			break
+		}
+		info = append(info, fmt.Sprintf("  %s (%s)", key.WorkflowID, key.RunID))
+	}
+	return info
+}
@@ -0,0 +1,187 @@
+package temporaltui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	temporalClient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+	"github.com/neomantra/tempted/internal/tui/message"
+)
+
+// ActivityTarget is a pending activity of a workflow, addressed by its ID
+type ActivityTarget struct {
+	Key        WorkflowKey
+	ActivityID string
+}
+
+// FetchPendingActivities lists the activities a workflow is waiting on
+func FetchPendingActivities(key WorkflowKey, client temporalClient.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		resp, err := client.DescribeWorkflowExecution(ctx, key.WorkflowID, key.RunID)
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+
+		var activityRows [][]string
+		var keys []string
+		for _, activity := range resp.PendingActivities {
+			attempt := fmt.Sprint(activity.Attempt)
+			if activity.MaximumAttempts > 0 {
+				attempt = fmt.Sprintf("%d/%d", activity.Attempt, activity.MaximumAttempts)
+			}
+			activityRows = append(activityRows, []string{
+				activity.ActivityId,
+				activity.ActivityType.GetName(),
+				activity.State.String(),
+				attempt,
+				formatter.FormatTimePtr(activity.ScheduledTime),
+				formatter.FormatTimePtr(activity.LastStartedTime),
+				formatter.FormatTimePtr(activity.LastHeartbeatTime),
+				activity.LastWorkerIdentity,
+			})
+			keys = append(keys, activity.ActivityId)
+		}
+
+		columns := []string{"Activity ID", "Type", "State", "Attempt", "Scheduled", "Last Started", "Last Heartbeat", "Last Worker"}
+		table := formatter.GetRenderedTableAsString(columns, activityRows)
+
+		var rows []page.Row
+		for idx, row := range table.ContentRows {
+			rows = append(rows, page.Row{Key: keys[idx], Row: row})
+		}
+		return PageLoadedMsg{
+			Page:        PendingActivitiesPage,
+			TableHeader: table.HeaderRows,
+			AllPageRows: rows,
+		}
+	}
+}
+
+func activityTargetInfo(target ActivityTarget) []string {
+	return append(workflowKeysInfo([]WorkflowKey{target.Key}), fmt.Sprintf("Activity ID: %s", target.ActivityID))
+}
+
+func CompleteActivityForm(target ActivityTarget) form.Model {
+	return form.New(form.Config{
+		Title: "Complete Activity",
+		Info:  activityTargetInfo(target),
+		Fields: []form.Field{
+			{Key: "result", Label: "JSON result", Placeholder: "optional, e.g. {\"key\": \"value\"}", Kind: form.MultiLine},
+		},
+		Validate: func(v form.Values) error {
+			_, err := parseJSONPayload(v["result"])
+			return err
+		},
+		Confirm: true,
+	})
+}
+
+func FailActivityForm(target ActivityTarget) form.Model {
+	return form.New(form.Config{
+		Title: "Fail Activity",
+		Info:  activityTargetInfo(target),
+		Fields: []form.Field{
+			{Key: "message", Label: "Failure message", Placeholder: "why the activity failed"},
+			{Key: "type", Label: "Failure type", Placeholder: "optional application error type"},
+			{Key: "details", Label: "JSON details", Placeholder: "optional, e.g. {\"key\": \"value\"}", Kind: form.MultiLine},
+			{Key: "nonRetryable", Label: "Non-retryable", Kind: form.Toggle},
+		},
+		Validate: func(v form.Values) error {
+			if strings.TrimSpace(v["message"]) == "" {
+				return errors.New("a failure message is required")
+			}
+			_, err := parseJSONPayload(v["details"])
+			return err
+		},
+		Confirm: true,
+	})
+}
+
+func HeartbeatActivityForm(target ActivityTarget) form.Model {
+	return form.New(form.Config{
+		Title: "Heartbeat Activity",
+		Info:  activityTargetInfo(target),
+		Fields: []form.Field{
+			{Key: "details", Label: "JSON details", Placeholder: "optional heartbeat details, e.g. {\"progress\": 50}", Kind: form.MultiLine},
+		},
+		Validate: func(v form.Values) error {
+			_, err := parseJSONPayload(v["details"])
+			return err
+		},
+		Confirm: true,
+	})
+}
+
+func CompleteActivity(client temporalClient.Client, namespace string, target ActivityTarget, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		result, err := parseJSONPayload(values["result"])
+		if err == nil {
+			err = client.CompleteActivityByID(ctx, namespace, target.Key.WorkflowID, target.Key.RunID, target.ActivityID, result, nil)
+		}
+		if err != nil {
+			return ActionCompletedMsg{Page: ActivityCompletePage, Err: err}
+		}
+		return ActionCompletedMsg{Page: ActivityCompletePage, Message: fmt.Sprintf("Completed activity %s", target.ActivityID)}
+	}
+}
+
+func FailActivity(client temporalClient.Client, namespace string, target ActivityTarget, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		details, err := parseJSONPayload(values["details"])
+		if err != nil {
+			return ActionCompletedMsg{Page: ActivityFailPage, Err: err}
+		}
+		var detailArgs []interface{}
+		if details != nil {
+			detailArgs = append(detailArgs, details)
+		}
+		failureMessage, errType := strings.TrimSpace(values["message"]), strings.TrimSpace(values["type"])
+		failure := temporal.NewApplicationError(failureMessage, errType, detailArgs...)
+		if values.Bool("nonRetryable") {
+			failure = temporal.NewNonRetryableApplicationError(failureMessage, errType, nil, detailArgs...)
+		}
+
+		if err := client.CompleteActivityByID(ctx, namespace, target.Key.WorkflowID, target.Key.RunID, target.ActivityID, nil, failure); err != nil {
+			return ActionCompletedMsg{Page: ActivityFailPage, Err: err}
+		}
+		return ActionCompletedMsg{Page: ActivityFailPage, Message: fmt.Sprintf("Failed activity %s", target.ActivityID)}
+	}
+}
+
+func HeartbeatActivity(client temporalClient.Client, namespace string, target ActivityTarget, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		details, err := parseJSONPayload(values["details"])
+		if err != nil {
+			return ActionCompletedMsg{Page: ActivityHeartbeatPage, Err: err}
+		}
+		var detailArgs []interface{}
+		if details != nil {
+			detailArgs = append(detailArgs, details)
+		}
+
+		if err := client.RecordActivityHeartbeatByID(ctx, namespace, target.Key.WorkflowID, target.Key.RunID, target.ActivityID, detailArgs...); err != nil {
+			return ActionCompletedMsg{Page: ActivityHeartbeatPage, Err: err}
+		}
+		return ActionCompletedMsg{Page: ActivityHeartbeatPage, Message: fmt.Sprintf("Recorded heartbeat of activity %s", target.ActivityID)}
+	}
+}
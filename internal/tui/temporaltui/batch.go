@@ -0,0 +1,323 @@
+package temporaltui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+	batchpb "go.temporal.io/api/batch/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalClient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+	"github.com/neomantra/tempted/internal/tui/message"
+)
+
+// batchProgressWidth is the number of characters of the progress bar of a batch operation
+const batchProgressWidth = 40
+
+// batchOperations are the operations a batch can apply to the workflows matching a query.
+// The server has no batch reset in this version of the API.
+var batchOperations = []string{"Terminate", "Cancel", "Signal", "Delete"}
+
+// FetchBatchForm counts the workflows matching query, to be shown before the batch is confirmed
+func FetchBatchForm(client temporalClient.Client, query string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		count, err := countWorkflows(ctx, client, query)
+		if err != nil {
+			return FormMsg{Page: WorkflowBatchPage, Err: err}
+		}
+		return FormMsg{Page: WorkflowBatchPage, Form: BatchWorkflowsForm(query, count)}
+	}
+}
+
+func BatchWorkflowsForm(query string, count int64) form.Model {
+	return form.New(form.Config{
+		Title: "Batch Operation",
+		Info: []string{
+			fmt.Sprintf("Query: %s", query),
+			fmt.Sprintf("Matches about %d workflows, counted before the batch starts.", count),
+			"",
+			"The server applies the operation to every workflow matching the query when it runs.",
+		},
+		Fields: []form.Field{
+			{Key: "operation", Label: "Operation", Placeholder: strings.Join(batchOperations, ", "), Suggestions: batchOperations},
+			{Key: "reason", Label: "Reason", Placeholder: "why the batch is being run"},
+			{Key: "signalName", Label: "Signal name", Placeholder: "for Signal, name of the signal"},
+			{Key: "signalPayload", Label: "Signal JSON payload", Placeholder: "for Signal, optional", Kind: form.MultiLine},
+		},
+		Validate: func(v form.Values) error {
+			op, err := parseBatchOperation(v["operation"])
+			if err != nil {
+				return err
+			}
+			if strings.TrimSpace(v["reason"]) == "" {
+				return errors.New("a reason is required")
+			}
+			if op == enumspb.BATCH_OPERATION_TYPE_SIGNAL {
+				if strings.TrimSpace(v["signalName"]) == "" {
+					return errors.New("a signal name is required")
+				}
+				_, err := parseJSONPayload(v["signalPayload"])
+				return err
+			}
+			return nil
+		},
+		Confirm: true,
+	})
+}
+
+// BatchWorkflows starts a batch operation on the workflows matching query, to be tracked on the Batch Operation page
+func BatchWorkflows(client temporalClient.Client, namespace, query string, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		request := &workflowservice.StartBatchOperationRequest{
+			Namespace:       namespace,
+			VisibilityQuery: query,
+			JobId:           uuid.New().String(),
+			Reason:          strings.TrimSpace(values["reason"]),
+		}
+
+		op, err := parseBatchOperation(values["operation"])
+		if err != nil {
+			return ActionCompletedMsg{Page: WorkflowBatchPage, Err: err}
+		}
+		switch op {
+		case enumspb.BATCH_OPERATION_TYPE_TERMINATE:
+			request.Operation = &workflowservice.StartBatchOperationRequest_TerminationOperation{
+				TerminationOperation: &batchpb.BatchOperationTermination{},
+			}
+		case enumspb.BATCH_OPERATION_TYPE_CANCEL:
+			request.Operation = &workflowservice.StartBatchOperationRequest_CancellationOperation{
+				CancellationOperation: &batchpb.BatchOperationCancellation{},
+			}
+		case enumspb.BATCH_OPERATION_TYPE_SIGNAL:
+			input, err := batchSignalInput(values["signalPayload"])
+			if err != nil {
+				return ActionCompletedMsg{Page: WorkflowBatchPage, Err: err}
+			}
+			request.Operation = &workflowservice.StartBatchOperationRequest_SignalOperation{
+				SignalOperation: &batchpb.BatchOperationSignal{Signal: strings.TrimSpace(values["signalName"]), Input: input},
+			}
+		case enumspb.BATCH_OPERATION_TYPE_DELETE:
+			request.Operation = &workflowservice.StartBatchOperationRequest_DeletionOperation{
+				DeletionOperation: &batchpb.BatchOperationDeletion{},
+			}
+		}
+
+		if _, err := client.WorkflowService().StartBatchOperation(ctx, request); err != nil {
+			return ActionCompletedMsg{Page: WorkflowBatchPage, Err: err}
+		}
+		return ActionCompletedMsg{
+			Page:       WorkflowBatchPage,
+			Message:    fmt.Sprintf("Started batch %s %s", op, request.JobId),
+			BatchJobID: request.JobId,
+		}
+	}
+}
+
+// FetchBatchOperation describes a batch operation and its progress
+func FetchBatchOperation(client temporalClient.Client, namespace, jobID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		resp, err := client.WorkflowService().DescribeBatchOperation(ctx, &workflowservice.DescribeBatchOperationRequest{
+			Namespace: namespace,
+			JobId:     jobID,
+		})
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+
+		var rows []page.Row
+		for _, row := range batchOperationAsRows(resp) {
+			rows = append(rows, page.Row{Key: "", Row: row})
+		}
+		return PageLoadedMsg{
+			Page:        BatchOperationPage,
+			TableHeader: []string{},
+			AllPageRows: rows,
+		}
+	}
+}
+
+// FetchBatchOperations lists the batch operations of a namespace, describing each for its type and progress.
+// A batch that fails to describe is still listed, with what the list holds of it.
+func FetchBatchOperations(client temporalClient.Client, namespace string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var nextPageToken []byte
+		var batches []batchOperationSummary
+		for {
+			resp, err := client.WorkflowService().ListBatchOperations(ctx, &workflowservice.ListBatchOperationsRequest{
+				Namespace:     namespace,
+				NextPageToken: nextPageToken,
+			})
+			if err != nil {
+				return message.ErrMsg{Err: err}
+			}
+			for _, info := range resp.OperationInfo {
+				desc, err := client.WorkflowService().DescribeBatchOperation(ctx, &workflowservice.DescribeBatchOperationRequest{
+					Namespace: namespace,
+					JobId:     info.JobId,
+				})
+				if err != nil {
+					// e.g. a batch deleted since it was listed, shown with what the list holds
+					desc = nil
+				}
+				batches = append(batches, batchOperationSummary{info: info, desc: desc})
+			}
+			nextPageToken = resp.NextPageToken
+			if len(nextPageToken) == 0 {
+				break
+			}
+		}
+
+		tableHeader, allPageData := batchOperationsAsTable(batches)
+		return PageLoadedMsg{
+			Page:        BatchOperationsPage,
+			TableHeader: tableHeader,
+			AllPageRows: allPageData,
+		}
+	}
+}
+
+func StopBatchOperationForm(jobID string) form.Model {
+	return form.New(form.Config{
+		Title: "Stop Batch Operation",
+		Info: []string{
+			fmt.Sprintf("Job ID: %s", jobID),
+			"",
+			"Workflows the batch has already processed are not restored.",
+		},
+		Fields: []form.Field{
+			{Key: "reason", Label: "Reason", Placeholder: "why the batch is being stopped"},
+		},
+		Validate: func(v form.Values) error {
+			if strings.TrimSpace(v["reason"]) == "" {
+				return errors.New("a reason is required")
+			}
+			return nil
+		},
+		Confirm: true,
+	})
+}
+
+func StopBatchOperation(client temporalClient.Client, namespace, jobID string, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, err := client.WorkflowService().StopBatchOperation(ctx, &workflowservice.StopBatchOperationRequest{
+			Namespace: namespace,
+			JobId:     jobID,
+			Reason:    strings.TrimSpace(values["reason"]),
+		})
+		if err != nil {
+			return ActionCompletedMsg{Page: BatchStopPage, Err: err}
+		}
+		return ActionCompletedMsg{Page: BatchStopPage, Message: fmt.Sprintf("Stopped batch %s", jobID)}
+	}
+}
+
+// batchOperationSummary is a row of the Batch Operations page, its description nil if it failed
+type batchOperationSummary struct {
+	info *batchpb.BatchOperationInfo
+	desc *workflowservice.DescribeBatchOperationResponse
+}
+
+func batchOperationsAsTable(batches []batchOperationSummary) ([]string, []page.Row) {
+	var batchRows [][]string
+	var keys []string
+	for _, batch := range batches {
+		row := []string{
+			batch.info.JobId,
+			"unknown",
+			batch.info.State.String(),
+			formatter.FormatTimePtr(batch.info.StartTime),
+			formatter.FormatTimePtr(batch.info.CloseTime),
+			"unknown",
+			"unknown",
+			"unknown",
+		}
+		if desc := batch.desc; desc != nil {
+			row[1], row[2] = desc.OperationType.String(), desc.State.String()
+			row[5], row[6], row[7] = fmt.Sprint(desc.TotalOperationCount), fmt.Sprint(desc.CompleteOperationCount), fmt.Sprint(desc.FailureOperationCount)
+		}
+		batchRows = append(batchRows, row)
+		keys = append(keys, batch.info.JobId)
+	}
+
+	columns := []string{"Job ID", "Type", "State", "Start Time", "Close Time", "Total", "Complete", "Failure"}
+	table := formatter.GetRenderedTableAsString(columns, batchRows)
+
+	var rows []page.Row
+	for idx, row := range table.ContentRows {
+		rows = append(rows, page.Row{Key: keys[idx], Row: row})
+	}
+	return table.HeaderRows, rows
+}
+
+func batchOperationAsRows(resp *workflowservice.DescribeBatchOperationResponse) []string {
+	done := resp.CompleteOperationCount + resp.FailureOperationCount
+	return []string{
+		fmt.Sprintf("Job ID:     %s", resp.JobId),
+		fmt.Sprintf("Operation:  %s", resp.OperationType),
+		fmt.Sprintf("State:      %s", resp.State),
+		fmt.Sprintf("Reason:     %s", resp.Reason),
+		fmt.Sprintf("Identity:   %s", resp.Identity),
+		fmt.Sprintf("Start Time: %s", formatter.FormatTimePtr(resp.StartTime)),
+		fmt.Sprintf("Close Time: %s", formatter.FormatTimePtr(resp.CloseTime)),
+		"",
+		fmt.Sprintf("Total:      %d", resp.TotalOperationCount),
+		fmt.Sprintf("Completed:  %d", resp.CompleteOperationCount),
+		fmt.Sprintf("Failed:     %d", resp.FailureOperationCount),
+		fmt.Sprintf("Progress:   %s %d/%d", batchProgressBar(done, resp.TotalOperationCount), done, resp.TotalOperationCount),
+	}
+}
+
+// batchProgressBar draws the share of done of total operations
+func batchProgressBar(done, total int64) string {
+	filled := 0
+	if total > 0 {
+		filled = int(done * batchProgressWidth / total)
+	}
+	if filled > batchProgressWidth {
+		filled = batchProgressWidth
+	}
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", batchProgressWidth-filled) + "]"
+}
+
+func parseBatchOperation(s string) (enumspb.BatchOperationType, error) {
+	for _, op := range batchOperations {
+		if strings.EqualFold(strings.TrimSpace(s), op) {
+			return enumspb.BatchOperationType(enumspb.BatchOperationType_value[op]), nil
+		}
+	}
+	return enumspb.BATCH_OPERATION_TYPE_UNSPECIFIED, fmt.Errorf("operation must be one of %s", strings.Join(batchOperations, ", "))
+}
+
+// batchSignalInput encodes the optional JSON payload of a batch signal
+func batchSignalInput(s string) (*commonpb.Payloads, error) {
+	payload, err := parseJSONPayload(s)
+	if err != nil || payload == nil {
+		return nil, err
+	}
+	return converter.GetDefaultDataConverter().ToPayloads(payload)
+}
@@ -0,0 +1,67 @@
+package temporaltui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	enumspb "go.temporal.io/api/enums/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+)
+
+// cancelRequestedStatus is shown as the status of running workflows that have been asked to cancel
+const cancelRequestedStatus = "cancel requested"
+
+func CancelWorkflowForm(keys []WorkflowKey) form.Model {
+	return form.New(form.Config{
+		Title: "Cancel Workflow",
+		Info: append(workflowKeysInfo(keys),
+			"",
+			"Cancellation is requested, letting workflows run their cleanup before they close.",
+		),
+		Confirm: true,
+	})
+}
+
+func CancelWorkflows(client temporalClient.Client, keys []WorkflowKey) tea.Cmd {
+	return runWorkflowsAction(WorkflowCancelPage, "Requested cancellation of", keys, func(ctx context.Context, key WorkflowKey) error {
+		return client.CancelWorkflow(ctx, key.WorkflowID, key.RunID)
+	})
+}
+
+// CancelRequestedMsg reports whether a running workflow has been asked to cancel
+type CancelRequestedMsg struct {
+	Key             WorkflowKey
+	CancelRequested bool
+	Err             error
+}
+
+// FetchCancelRequested reads whether a running workflow has been asked to cancel, by any client, from the
+// cancel request recorded in its history, as the describe response of API 1.19 doesn't say
+func FetchCancelRequested(client temporalClient.Client, key WorkflowKey) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		resp, err := client.DescribeWorkflowExecution(ctx, key.WorkflowID, key.RunID)
+		if err != nil {
+			return CancelRequestedMsg{Key: key, Err: err}
+		}
+		if resp.GetWorkflowExecutionInfo().GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			return CancelRequestedMsg{Key: key}
+		}
+
+		iter := client.GetWorkflowHistory(ctx, key.WorkflowID, key.RunID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+		for iter.HasNext() {
+			event, err := iter.Next()
+			if err != nil {
+				return CancelRequestedMsg{Key: key, Err: err}
+			}
+			if event.EventType == enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED {
+				return CancelRequestedMsg{Key: key, CancelRequested: true}
+			}
+		}
+		return CancelRequestedMsg{Key: key}
+	}
+}
@@ -0,0 +1,42 @@
+package temporaltui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+)
+
+func DeleteWorkflowForm(keys []WorkflowKey) form.Model {
+	// retyping workflow IDs guards against deleting the wrong ones; of several, the first and last are retyped
+	info := append(workflowKeysInfo(keys),
+		"",
+		"Deletion removes the workflows and their histories, terminating any that are running. It cannot be undone.",
+	)
+	confirmText := keys[0].WorkflowID
+	if len(keys) > 1 {
+		confirmText = fmt.Sprintf("%s %s", keys[0].WorkflowID, keys[len(keys)-1].WorkflowID)
+		info = append(info, "To confirm, type the IDs of the first and last of these workflows, separated by a space.")
+	}
+	return form.New(form.Config{
+		Title:       "Delete Workflow",
+		Info:        info,
+		Confirm:     true,
+		ConfirmText: confirmText,
+	})
+}
+
+func DeleteWorkflows(client temporalClient.Client, namespace string, keys []WorkflowKey) tea.Cmd {
+	return runWorkflowsAction(WorkflowDeletePage, "Deleted", keys, func(ctx context.Context, key WorkflowKey) error {
+		_, err := client.WorkflowService().DeleteWorkflowExecution(ctx, &workflowservice.DeleteWorkflowExecutionRequest{
+			Namespace:         namespace,
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: key.WorkflowID, RunId: key.RunID},
+		})
+		return err
+	})
+}
@@ -0,0 +1,271 @@
+package temporaltui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	historypb "go.temporal.io/api/history/v1"
+
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/constants"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+	"github.com/neomantra/tempted/internal/tui/message"
+)
+
+const (
+	diffSame      = "same"
+	diffDiffers   = "differs"
+	diffLeftOnly  = "left only"
+	diffRightOnly = "right only"
+
+	// maxDiffAlignmentCells bounds the memory used to align two histories by event type.
+	// Larger pairs of histories are compared event by event instead.
+	maxDiffAlignmentCells = 4_000_000
+)
+
+// diffIgnoredAttributes are event attributes that differ between any two runs,
+// e.g. references to other event IDs or request IDs, and so are left out of diffs.
+var diffIgnoredAttributes = map[string]bool{
+	"identity":                     true,
+	"requestId":                    true,
+	"runId":                        true,
+	"originalExecutionRunId":       true,
+	"firstExecutionRunId":          true,
+	"continuedExecutionRunId":      true,
+	"newExecutionRunId":            true,
+	"scheduledEventId":             true,
+	"startedEventId":               true,
+	"initiatedEventId":             true,
+	"workflowTaskCompletedEventId": true,
+	"prevAutoResetPoints":          true,
+}
+
+type diffPair struct {
+	left, right *historypb.HistoryEvent
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+func FetchWorkflowDiff(left, right HistorySource) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		leftEvents, err := left.events(ctx)
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+		rightEvents, err := right.events(ctx)
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+
+		tableHeader, allPageData, err := historyDiffAsTable(alignHistories(leftEvents, rightEvents))
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+		return PageLoadedMsg{
+			Page:        WorkflowDiffPage,
+			TableHeader: tableHeader,
+			AllPageRows: allPageData,
+		}
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+// alignHistories pairs up the events of two histories by the longest common
+// subsequence of their event types. Unpaired events have a nil counterpart.
+func alignHistories(left, right []*historypb.HistoryEvent) []diffPair {
+	n, m := len(left), len(right)
+	if n*m > maxDiffAlignmentCells {
+		var pairs []diffPair
+		for i := 0; i < n || i < m; i++ {
+			var pair diffPair
+			if i < n {
+				pair.left = left[i]
+			}
+			if i < m {
+				pair.right = right[i]
+			}
+			pairs = append(pairs, pair)
+		}
+		return pairs
+	}
+
+	// lcs[i][j] is the length of the common subsequence of left[i:] and right[j:]
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if left[i].EventType == right[j].EventType {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var pairs []diffPair
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case left[i].EventType == right[j].EventType:
+			pairs = append(pairs, diffPair{left[i], right[j]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			pairs = append(pairs, diffPair{left: left[i]})
+			i++
+		default:
+			pairs = append(pairs, diffPair{right: right[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		pairs = append(pairs, diffPair{left: left[i]})
+	}
+	for ; j < m; j++ {
+		pairs = append(pairs, diffPair{right: right[j]})
+	}
+	return pairs
+}
+
+func historyDiffAsTable(pairs []diffPair) ([]string, []page.Row, error) {
+	var diffRows [][]string
+	var attributeDiffs [][]string
+	for _, pair := range pairs {
+		status := diffSame
+		var diffs []string
+		switch {
+		case pair.right == nil:
+			status = diffLeftOnly
+		case pair.left == nil:
+			status = diffRightOnly
+		default:
+			var err error
+			if diffs, err = eventAttributeDiffs(pair.left, pair.right); err != nil {
+				return nil, nil, err
+			}
+			if len(diffs) > 0 {
+				status = diffDiffers
+			}
+		}
+
+		leftID, leftType := diffEventColumns(pair.left)
+		rightID, rightType := diffEventColumns(pair.right)
+		diffRows = append(diffRows, []string{leftID, leftType, status, rightID, rightType})
+		attributeDiffs = append(attributeDiffs, diffs)
+	}
+
+	columns := []string{"Left", "Left Type", "Diff", "Right", "Right Type"}
+	table := formatter.GetRenderedTableAsString(columns, diffRows)
+
+	var rows []page.Row
+	for idx, row := range table.ContentRows {
+		rows = append(rows, page.Row{Key: "", Row: row})
+		for _, d := range attributeDiffs[idx] {
+			rows = append(rows, page.Row{Key: "", Row: constants.DiffAttributePrefix + d})
+		}
+	}
+
+	return table.HeaderRows, rows, nil
+}
+
+func diffEventColumns(event *historypb.HistoryEvent) (string, string) {
+	if event == nil {
+		return "", ""
+	}
+	return strconv.FormatInt(event.EventId, 10), event.EventType.String()
+}
+
+// eventAttributeDiffs compares the decoded attributes of two events of the same type,
+// returning one line per attribute path that differs.
+func eventAttributeDiffs(left, right *historypb.HistoryEvent) ([]string, error) {
+	leftAttributes, err := flatEventAttributes(left)
+	if err != nil {
+		return nil, err
+	}
+	rightAttributes, err := flatEventAttributes(right)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string]bool)
+	for path := range leftAttributes {
+		paths[path] = true
+	}
+	for path := range rightAttributes {
+		paths[path] = true
+	}
+
+	var diffs []string
+	for path := range paths {
+		leftValue, inLeft := leftAttributes[path]
+		rightValue, inRight := rightAttributes[path]
+		if inLeft && inRight && leftValue == rightValue {
+			continue
+		}
+		if !inLeft {
+			leftValue = "<none>"
+		}
+		if !inRight {
+			rightValue = "<none>"
+		}
+		diffs = append(diffs, fmt.Sprintf("%s: %s -> %s", path, leftValue, rightValue))
+	}
+	sort.Strings(diffs)
+	return diffs, nil
+}
+
+// flatEventAttributes returns the decoded attributes of an event keyed by their JSON path.
+func flatEventAttributes(event *historypb.HistoryEvent) (map[string]string, error) {
+	decoded, err := decodedEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	flat := make(map[string]string)
+	if fields, ok := decoded.(map[string]interface{}); ok {
+		for k, v := range fields {
+			if strings.HasSuffix(k, "EventAttributes") {
+				flattenJSON("", v, flat)
+			}
+		}
+	}
+	return flat, nil
+}
+
+func flattenJSON(path string, v interface{}, flat map[string]string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			if diffIgnoredAttributes[k] {
+				continue
+			}
+			childPath := k
+			if path != "" {
+				childPath = path + "." + k
+			}
+			flattenJSON(childPath, child, flat)
+		}
+	case []interface{}:
+		for i, child := range v {
+			flattenJSON(fmt.Sprintf("%s[%d]", path, i), child, flat)
+		}
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			flat[path] = fmt.Sprint(v)
+		} else {
+			flat[path] = string(b)
+		}
+	}
+}
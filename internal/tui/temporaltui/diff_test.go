@@ -0,0 +1,111 @@
+package temporaltui
+
+import (
+	"testing"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+)
+
+func historyOfTypes(types ...enumspb.EventType) []*historypb.HistoryEvent {
+	var events []*historypb.HistoryEvent
+	for idx, eventType := range types {
+		events = append(events, &historypb.HistoryEvent{EventId: int64(idx + 1), EventType: eventType})
+	}
+	return events
+}
+
+// pairIDs describes pairs as the IDs of their events, 0 for a missing one
+func pairIDs(pairs []diffPair) [][2]int64 {
+	var ids [][2]int64
+	for _, pair := range pairs {
+		ids = append(ids, [2]int64{pair.left.GetEventId(), pair.right.GetEventId()})
+	}
+	return ids
+}
+
+func TestAlignHistories(t *testing.T) {
+	const (
+		started   = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED
+		scheduled = enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED
+		signaled  = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED
+		timer     = enumspb.EVENT_TYPE_TIMER_STARTED
+		completed = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED
+	)
+	tests := []struct {
+		name        string
+		left, right []*historypb.HistoryEvent
+		want        [][2]int64
+	}{
+		{
+			name:  "identical",
+			left:  historyOfTypes(started, scheduled, completed),
+			right: historyOfTypes(started, scheduled, completed),
+			want:  [][2]int64{{1, 1}, {2, 2}, {3, 3}},
+		},
+		{
+			name:  "right has an extra event",
+			left:  historyOfTypes(started, scheduled, completed),
+			right: historyOfTypes(started, signaled, scheduled, completed),
+			want:  [][2]int64{{1, 1}, {0, 2}, {2, 3}, {3, 4}},
+		},
+		{
+			name:  "events differ in the middle",
+			left:  historyOfTypes(started, timer, completed),
+			right: historyOfTypes(started, scheduled, completed),
+			want:  [][2]int64{{1, 1}, {2, 0}, {0, 2}, {3, 3}},
+		},
+		{
+			name:  "left is longer",
+			left:  historyOfTypes(started, scheduled, completed),
+			right: historyOfTypes(started),
+			want:  [][2]int64{{1, 1}, {2, 0}, {3, 0}},
+		},
+		{
+			name:  "empty",
+			left:  nil,
+			right: historyOfTypes(started),
+			want:  [][2]int64{{0, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pairIDs(alignHistories(tt.left, tt.right))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for idx := range got {
+				if got[idx] != tt.want[idx] {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAlignHistoriesFallback(t *testing.T) {
+	// too large to align by event type, so events are paired by position
+	n := 2001
+	if n*n <= maxDiffAlignmentCells {
+		t.Fatalf("%d events per history no longer exceed the alignment bound", n)
+	}
+	var leftTypes, rightTypes []enumspb.EventType
+	for i := 0; i < n; i++ {
+		leftTypes = append(leftTypes, enumspb.EVENT_TYPE_TIMER_STARTED)
+		rightTypes = append(rightTypes, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED)
+	}
+	rightTypes = append(rightTypes, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED)
+
+	pairs := alignHistories(historyOfTypes(leftTypes...), historyOfTypes(rightTypes...))
+	if len(pairs) != n+1 {
+		t.Fatalf("got %d pairs, want %d", len(pairs), n+1)
+	}
+	for idx, pair := range pairs[:n] {
+		if pair.left.GetEventId() != int64(idx+1) || pair.right.GetEventId() != int64(idx+1) {
+			t.Fatalf("pair %d is %v, want events %d paired", idx, pairIDs(pairs[idx:idx+1]), idx+1)
+		}
+	}
+	if last := pairs[n]; last.left != nil || last.right.GetEventId() != int64(n+1) {
+		t.Errorf("last pair is %v, want only the right event %d", pairIDs(pairs[n:]), n+1)
+	}
+}
@@ -0,0 +1,352 @@
+package temporaltui
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	temporalClient "go.temporal.io/sdk/client"
+	"go.temporal.io/server/common/codec"
+
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+	"github.com/neomantra/tempted/internal/tui/message"
+)
+
+const timelineWidth = 50
+
+// HistorySource describes where a workflow history is read from: either a
+// Temporal cluster via Client and Key, or an exported history JSON file.
+type HistorySource struct {
+	Client   temporalClient.Client
+	Key      WorkflowKey
+	FilePath string
+}
+
+func (s HistorySource) Offline() bool {
+	return s.FilePath != ""
+}
+
+func (s HistorySource) events(ctx context.Context) ([]*historypb.HistoryEvent, error) {
+	if s.Offline() {
+		return readHistoryFile(s.FilePath)
+	}
+
+	var events []*historypb.HistoryEvent
+	iter := s.Client.GetWorkflowHistory(ctx, s.Key.WorkflowID, s.Key.RunID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+func FetchWorkflowHistory(source HistorySource) tea.Cmd {
+	return func() tea.Msg {
+		events, err := source.events(context.Background())
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+
+		tableHeader, allPageData := historyEventsAsTable(events)
+		return PageLoadedMsg{
+			Page:        WorkflowHistoryPage,
+			TableHeader: tableHeader,
+			AllPageRows: allPageData,
+		}
+	}
+}
+
+func FetchHistoryEvent(source HistorySource, eventID int64) tea.Cmd {
+	return func() tea.Msg {
+		events, err := source.events(context.Background())
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+
+		var event *historypb.HistoryEvent
+		for _, e := range events {
+			if e.EventId == eventID {
+				event = e
+				break
+			}
+		}
+		if event == nil {
+			return message.ErrMsg{Err: fmt.Errorf("event %d not found in history", eventID)}
+		}
+
+		decoded, err := decodedEvent(event)
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+		eventBytes, err := json.Marshal(decoded)
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+
+		var rows []page.Row
+		for _, row := range formatter.PrettyJsonStringAsLines(string(eventBytes)) {
+			rows = append(rows, page.Row{Key: "", Row: row})
+		}
+
+		return PageLoadedMsg{
+			Page:        HistoryEventPage,
+			TableHeader: []string{},
+			AllPageRows: rows,
+		}
+	}
+}
+
+func FetchHistoryTimeline(source HistorySource) tea.Cmd {
+	return func() tea.Msg {
+		events, err := source.events(context.Background())
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+
+		tableHeader, allPageData := historyEventsAsTimeline(events)
+		return PageLoadedMsg{
+			Page:        HistoryTimelinePage,
+			TableHeader: tableHeader,
+			AllPageRows: allPageData,
+		}
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+// readHistoryFile reads a history exported as JSON, e.g. by tctl or the Temporal Web UI.
+func readHistoryFile(path string) ([]*historypb.HistoryEvent, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	history, err := temporalClient.HistoryFromJSON(f, temporalClient.HistoryJSONOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not parse history file %s: %w", path, err)
+	}
+	return history.Events, nil
+}
+
+func historyEventsAsTable(events []*historypb.HistoryEvent) ([]string, []page.Row) {
+	var eventRows [][]string
+	var keys []string
+	for _, event := range events {
+		eventRows = append(eventRows, []string{
+			strconv.FormatInt(event.EventId, 10),
+			formatter.FormatTimePtr(event.EventTime),
+			event.EventType.String(),
+			historyEventSummary(event),
+		})
+		keys = append(keys, formatEventKey(event))
+	}
+
+	columns := []string{"ID", "Time", "Type", "Summary"}
+	table := formatter.GetRenderedTableAsString(columns, eventRows)
+
+	var rows []page.Row
+	for idx, row := range table.ContentRows {
+		rows = append(rows, page.Row{Key: keys[idx], Row: row})
+	}
+
+	return table.HeaderRows, rows
+}
+
+// historyEventsAsTimeline renders each event with its elapsed time since the first event
+// and a marker placed proportionally along the whole duration of the history.
+func historyEventsAsTimeline(events []*historypb.HistoryEvent) ([]string, []page.Row) {
+	var start, end, prev time.Time
+	for _, event := range events {
+		if event.EventTime == nil {
+			continue
+		}
+		if start.IsZero() || event.EventTime.Before(start) {
+			start = *event.EventTime
+		}
+		if event.EventTime.After(end) {
+			end = *event.EventTime
+		}
+	}
+	total := end.Sub(start)
+
+	var eventRows [][]string
+	var keys []string
+	for _, event := range events {
+		elapsed, delta, bar := "-", "-", ""
+		if event.EventTime != nil {
+			t := *event.EventTime
+			elapsed = formatDuration(t.Sub(start))
+			if !prev.IsZero() {
+				delta = "+" + formatDuration(t.Sub(prev))
+			}
+			prev = t
+
+			pos := 0
+			if total > 0 {
+				pos = int(float64(t.Sub(start)) / float64(total) * float64(timelineWidth-1))
+			}
+			bar = strings.Repeat(".", pos) + "#" + strings.Repeat(".", timelineWidth-1-pos)
+		}
+		eventRows = append(eventRows, []string{
+			strconv.FormatInt(event.EventId, 10),
+			elapsed,
+			delta,
+			event.EventType.String(),
+			bar,
+		})
+		keys = append(keys, formatEventKey(event))
+	}
+
+	columns := []string{"ID", "Elapsed", "Delta", "Type", fmt.Sprintf("Timeline (%s)", formatDuration(total))}
+	table := formatter.GetRenderedTableAsString(columns, eventRows)
+
+	var rows []page.Row
+	for idx, row := range table.ContentRows {
+		rows = append(rows, page.Row{Key: keys[idx], Row: row})
+	}
+
+	return table.HeaderRows, rows
+}
+
+// historyEventSummary returns a short description of the most relevant attributes of an event.
+func historyEventSummary(event *historypb.HistoryEvent) string {
+	switch event.EventType {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+		attrs := event.GetWorkflowExecutionStartedEventAttributes()
+		return fmt.Sprintf("%s on %s", attrs.GetWorkflowType().GetName(), attrs.GetTaskQueue().GetName())
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+		attrs := event.GetActivityTaskScheduledEventAttributes()
+		return fmt.Sprintf("%s (%s)", attrs.GetActivityType().GetName(), attrs.GetActivityId())
+	case enumspb.EVENT_TYPE_TIMER_STARTED:
+		attrs := event.GetTimerStartedEventAttributes()
+		var timeout time.Duration
+		if attrs.GetStartToFireTimeout() != nil {
+			timeout = *attrs.GetStartToFireTimeout()
+		}
+		return fmt.Sprintf("%s (%s)", attrs.GetTimerId(), timeout)
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		return event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName()
+	case enumspb.EVENT_TYPE_MARKER_RECORDED:
+		return event.GetMarkerRecordedEventAttributes().GetMarkerName()
+	case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+		attrs := event.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+		return fmt.Sprintf("%s (%s)", attrs.GetWorkflowType().GetName(), attrs.GetWorkflowId())
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return event.GetWorkflowExecutionFailedEventAttributes().GetFailure().GetMessage()
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED:
+		return event.GetActivityTaskFailedEventAttributes().GetFailure().GetMessage()
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED:
+		return event.GetWorkflowTaskFailedEventAttributes().GetFailure().GetMessage()
+	}
+	return ""
+}
+
+// decodedEvent returns the JSON representation of an event as a generic value,
+// with every payload replaced by its decoded value.
+func decodedEvent(event *historypb.HistoryEvent) (interface{}, error) {
+	b, err := codec.NewJSONPBEncoder().Encode(event)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := unmarshalJSON(b, &v); err != nil {
+		return nil, err
+	}
+	return decodePayloads(v), nil
+}
+
+// decodePayloads walks JSON-encoded protobuf values and replaces payload objects,
+// i.e. objects with base64 "metadata" and "data", with their decoded contents.
+func decodePayloads(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if decoded, ok := decodePayload(v); ok {
+			return decoded
+		}
+		for k, child := range v {
+			v[k] = decodePayloads(child)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = decodePayloads(child)
+		}
+		return v
+	}
+	return v
+}
+
+func decodePayload(m map[string]interface{}) (interface{}, bool) {
+	metadata, ok := m["metadata"].(map[string]interface{})
+	if !ok || len(m) > 2 {
+		return nil, false
+	}
+	encodedEncoding, ok := metadata["encoding"].(string)
+	if !ok {
+		return nil, false
+	}
+	encoding, err := base64.StdEncoding.DecodeString(encodedEncoding)
+	if err != nil {
+		return nil, false
+	}
+
+	encodedData, _ := m["data"].(string)
+	data, err := base64.StdEncoding.DecodeString(encodedData)
+	if err != nil {
+		return nil, false
+	}
+
+	switch string(encoding) {
+	case "binary/null":
+		return nil, true
+	case "json/plain", "json/protobuf":
+		var decoded interface{}
+		if err := unmarshalJSON(data, &decoded); err != nil {
+			return string(data), true
+		}
+		return decoded, true
+	}
+	return fmt.Sprintf("<%s: %d bytes>", encoding, len(data)), true
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+func formatEventKey(event *historypb.HistoryEvent) string {
+	return fmt.Sprintf("%d %s", event.EventId, event.EventType)
+}
+
+func EventIDFromKey(key string) int64 {
+	id, _ := strconv.ParseInt(strings.Split(key, " ")[0], 10, 64)
+	return id
+}
+
+func EventTypeFromKey(key string) enumspb.EventType {
+	split := strings.Split(key, " ")
+	if len(split) < 2 {
+		return enumspb.EVENT_TYPE_UNSPECIFIED
+	}
+	return enumspb.EventType(enumspb.EventType_value[split[1]])
+}
+
+func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(100 * time.Millisecond).String()
+}
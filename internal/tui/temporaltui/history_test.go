@@ -0,0 +1,142 @@
+package temporaltui
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+const historyFixture = "testdata/history.json"
+
+func TestReadHistoryFile(t *testing.T) {
+	events, err := readHistoryFile(historyFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantTypes := []enumspb.EventType{
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+	}
+	if len(events) != len(wantTypes) {
+		t.Fatalf("got %d events, want %d", len(events), len(wantTypes))
+	}
+	for idx, event := range events {
+		if event.EventId != int64(idx+1) {
+			t.Errorf("event %d has ID %d", idx, event.EventId)
+		}
+		if event.EventType != wantTypes[idx] {
+			t.Errorf("event %d has type %s, want %s", idx, event.EventType, wantTypes[idx])
+		}
+	}
+	if got := events[0].GetWorkflowExecutionStartedEventAttributes().GetWorkflowType().GetName(); got != "OrderWorkflow" {
+		t.Errorf("got workflow type %q, want OrderWorkflow", got)
+	}
+}
+
+func TestReadHistoryFileErrors(t *testing.T) {
+	for _, path := range []string{"testdata/missing.json", "history_test.go"} {
+		if _, err := readHistoryFile(path); err == nil {
+			t.Errorf("%s: expected an error", path)
+		}
+	}
+}
+
+func TestDecodedEventPayloads(t *testing.T) {
+	events, err := readHistoryFile(historyFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		eventID int64
+		path    []string
+		want    string
+	}{
+		{"JSON string", 1, []string{"workflowExecutionStartedEventAttributes", "input", "payloads"}, `["order-42",{"id":12345678901234567890,"qty":2}]`},
+		{"binary null", 5, []string{"workflowExecutionCompletedEventAttributes", "result", "payloads"}, `[null]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := decodedEvent(events[tt.eventID-1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			v := decoded
+			for _, key := range tt.path {
+				object, ok := v.(map[string]interface{})
+				if !ok {
+					t.Fatalf("no object at %s in %v", key, v)
+				}
+				v = object[key]
+			}
+			got, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodePayloads(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want interface{}
+	}{
+		{
+			name: "json payload",
+			in:   `{"metadata": {"encoding": "anNvbi9wbGFpbg=="}, "data": "Im9yZGVyLTQyIg=="}`,
+			want: "order-42",
+		},
+		{
+			name: "null payload",
+			in:   `{"metadata": {"encoding": "YmluYXJ5L251bGw="}}`,
+			want: nil,
+		},
+		{
+			name: "binary payload",
+			in:   `{"metadata": {"encoding": "YmluYXJ5L3BsYWlu"}, "data": "YWJj"}`,
+			want: "<binary/plain: 3 bytes>",
+		},
+		{
+			name: "nested payloads",
+			in:   `{"input": {"payloads": [{"metadata": {"encoding": "anNvbi9wbGFpbg=="}, "data": "Im9yZGVyLTQyIg=="}]}}`,
+			want: map[string]interface{}{"input": map[string]interface{}{"payloads": []interface{}{"order-42"}}},
+		},
+		{
+			name: "object with more than payload fields",
+			in:   `{"metadata": {"encoding": "anNvbi9wbGFpbg=="}, "data": "Im9yZGVyLTQyIg==", "other": 1}`,
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{"encoding": "anNvbi9wbGFpbg=="},
+				"data":     "Im9yZGVyLTQyIg==",
+				"other":    json.Number("1"),
+			},
+		},
+		{
+			name: "invalid base64",
+			in:   `{"metadata": {"encoding": "not base64!"}, "data": ""}`,
+			want: map[string]interface{}{"metadata": map[string]interface{}{"encoding": "not base64!"}, "data": ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v interface{}
+			if err := unmarshalJSON([]byte(tt.in), &v); err != nil {
+				t.Fatal(err)
+			}
+			if got := decodePayloads(v); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package temporaltui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+	"github.com/neomantra/tempted/internal/tui/message"
+)
+
+// FetchNamespaces lists the namespaces of the cluster, sorted by name, marking the active one
+func FetchNamespaces(client temporalClient.Client, activeNamespace string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var nextPageToken []byte
+		var namespaces []*workflowservice.DescribeNamespaceResponse
+		for {
+			resp, err := client.WorkflowService().ListNamespaces(ctx, &workflowservice.ListNamespacesRequest{
+				NextPageToken: nextPageToken,
+			})
+			if err != nil {
+				return message.ErrMsg{Err: err}
+			}
+			namespaces = append(namespaces, resp.Namespaces...)
+			nextPageToken = resp.NextPageToken
+			if len(nextPageToken) == 0 {
+				break
+			}
+		}
+		sort.Slice(namespaces, func(x, y int) bool {
+			return namespaces[x].NamespaceInfo.GetName() < namespaces[y].NamespaceInfo.GetName()
+		})
+
+		tableHeader, allPageData := namespacesAsTable(namespaces, activeNamespace)
+		return PageLoadedMsg{
+			Page:        NamespacesPage,
+			TableHeader: tableHeader,
+			AllPageRows: allPageData,
+		}
+	}
+}
+
+func namespacesAsTable(namespaces []*workflowservice.DescribeNamespaceResponse, activeNamespace string) ([]string, []page.Row) {
+	var namespaceRows [][]string
+	var keys []string
+	for _, ns := range namespaces {
+		name := ns.NamespaceInfo.GetName()
+		active := ""
+		if name == activeNamespace {
+			active = "active"
+		}
+		retention := ""
+		if ttl := ns.Config.GetWorkflowExecutionRetentionTtl(); ttl != nil {
+			retention = formatRetention(*ttl)
+		}
+		namespaceRows = append(namespaceRows, []string{
+			name,
+			active,
+			ns.NamespaceInfo.GetState().String(),
+			retention,
+			ns.NamespaceInfo.GetDescription(),
+		})
+		keys = append(keys, name)
+	}
+
+	columns := []string{"Namespace", "Active", "State", "Retention", "Description"}
+	table := formatter.GetRenderedTableAsString(columns, namespaceRows)
+
+	var rows []page.Row
+	for idx, row := range table.ContentRows {
+		rows = append(rows, page.Row{Key: keys[idx], Row: row})
+	}
+	return table.HeaderRows, rows
+}
+
+// formatRetention shows a retention period in days, as namespaces are configured
+func formatRetention(d time.Duration) string {
+	day := 24 * time.Hour
+	if d < day || d%day != 0 {
+		return d.String()
+	}
+	return fmt.Sprintf("%dd", d/day)
+}
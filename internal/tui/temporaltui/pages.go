@@ -5,11 +5,13 @@ import (
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 
 	//"github.com/hashicorp/nomad/api"
 	"strings"
 	"time"
 
+	"github.com/neomantra/tempted/internal/tui/components/form"
 	"github.com/neomantra/tempted/internal/tui/components/page"
 	"github.com/neomantra/tempted/internal/tui/components/viewport"
 	"github.com/neomantra/tempted/internal/tui/constants"
@@ -24,64 +26,152 @@ const (
 	WorkflowsPage
 	WorkflowDetailsPage
 	WorkflowTermPage
+	WorkflowCancelPage
+	WorkflowSignalPage
+	WorkflowStartPage
+	WorkflowSignalWithStartPage
+	WorkflowHistoryPage
+	HistoryEventPage
+	HistoryTimelinePage
+	WorkflowDiffPage
+	WorkflowResetPointsPage
+	WorkflowResetPage
+	WorkflowsQueryPage
+	WorkflowBatchPage
+	BatchOperationPage
+	BatchOperationsPage
+	BatchStopPage
+	WorkflowDeletePage
+	WorkflowUpdatePage
+	WorkflowUpdatesPage
+	WorkflowUpdateResultPage
+	WorkflowUpdateAttachPage
+	PendingActivitiesPage
+	ActivityCompletePage
+	ActivityFailPage
+	ActivityHeartbeatPage
+	SchedulesPage
+	ScheduleDetailsPage
+	SchedulePausePage
+	ScheduleUnpausePage
+	ScheduleTriggerPage
+	ScheduleBackfillPage
+	ScheduleDeletePage
+	ScheduleCreatePage
+	ScheduleEditPage
+	TaskQueueSelectPage
+	TaskQueuePage
+	BuildIDsPage
+	NamespacesPage
 )
 
+// pageKind is how a page shows its content, which decides its page.Config and whether it loads, reloads and updates
+type pageKind int8
+
+const (
+	// listPage shows rows to select, e.g. workflows
+	listPage pageKind = iota
+	// detailsPage shows wrapped text, e.g. a workflow's details
+	detailsPage
+	// viewPage shows unwrapped text without selection, e.g. a history diff
+	viewPage
+	// formPage takes input without changing server state, e.g. a workflows query, and doesn't load
+	formPage
+	// actionPage takes input for an action that changes server state, e.g. a workflow reset, and doesn't load
+	actionPage
+)
+
+type pageInfo struct {
+	kind                     pageKind
+	filterPrefix             string
+	viewportConditionalStyle map[string]lipgloss.Style
+	markingEnabled           bool
+	// static pages load once and never update, e.g. a recorded event
+	static bool
+}
+
+// pageInfos describes every page, so a new page is added here once
+var pageInfos = map[Page]pageInfo{
+	WorkflowsPage:               {kind: listPage, filterPrefix: "Jobs", viewportConditionalStyle: constants.JobsViewportConditionalStyle, markingEnabled: true},
+	WorkflowDetailsPage:         {kind: detailsPage},
+	WorkflowTermPage:            {kind: actionPage},
+	WorkflowCancelPage:          {kind: actionPage},
+	WorkflowSignalPage:          {kind: actionPage},
+	WorkflowStartPage:           {kind: actionPage},
+	WorkflowSignalWithStartPage: {kind: actionPage},
+	WorkflowHistoryPage:         {kind: listPage},
+	HistoryEventPage:            {kind: detailsPage, static: true},
+	HistoryTimelinePage:         {kind: listPage},
+	WorkflowDiffPage:            {kind: viewPage, viewportConditionalStyle: constants.DiffViewportConditionalStyle},
+	WorkflowResetPointsPage:     {kind: listPage},
+	WorkflowResetPage:           {kind: actionPage},
+	WorkflowsQueryPage:          {kind: formPage},
+	WorkflowBatchPage:           {kind: actionPage},
+	BatchOperationPage:          {kind: detailsPage},
+	BatchOperationsPage:         {kind: listPage, viewportConditionalStyle: constants.BatchOperationsViewportConditionalStyle},
+	BatchStopPage:               {kind: actionPage},
+	WorkflowDeletePage:          {kind: actionPage},
+	WorkflowUpdatePage:          {kind: actionPage},
+	WorkflowUpdatesPage:         {kind: listPage},
+	WorkflowUpdateResultPage:    {kind: detailsPage},
+	WorkflowUpdateAttachPage:    {kind: formPage},
+	PendingActivitiesPage:       {kind: listPage},
+	ActivityCompletePage:        {kind: actionPage},
+	ActivityFailPage:            {kind: actionPage},
+	ActivityHeartbeatPage:       {kind: actionPage},
+	SchedulesPage:               {kind: listPage, viewportConditionalStyle: constants.SchedulesViewportConditionalStyle},
+	ScheduleDetailsPage:         {kind: listPage},
+	SchedulePausePage:           {kind: actionPage},
+	ScheduleUnpausePage:         {kind: actionPage},
+	ScheduleTriggerPage:         {kind: actionPage},
+	ScheduleBackfillPage:        {kind: actionPage},
+	ScheduleDeletePage:          {kind: actionPage},
+	ScheduleCreatePage:          {kind: actionPage},
+	ScheduleEditPage:            {kind: actionPage},
+	TaskQueueSelectPage:         {kind: formPage},
+	TaskQueuePage:               {kind: viewPage},
+	BuildIDsPage:                {kind: viewPage, viewportConditionalStyle: constants.BuildIDsViewportConditionalStyle},
+	NamespacesPage:              {kind: listPage, viewportConditionalStyle: constants.NamespacesViewportConditionalStyle},
+}
+
 func GetAllPageConfigs(width, height int, copySavePath bool) map[Page]page.Config {
-	return map[Page]page.Config{
-		WorkflowsPage: {
+	configs := make(map[Page]page.Config, len(pageInfos))
+	for p, info := range pageInfos {
+		configs[p] = page.Config{
 			Width: width, Height: height,
-			FilterPrefix: "Jobs", LoadingString: WorkflowsPage.LoadingString(),
-			CopySavePath: copySavePath, SelectionEnabled: true, WrapText: false, RequestInput: false,
-			ViewportConditionalStyle: constants.JobsViewportConditionalStyle,
-		},
-		WorkflowDetailsPage: {
-			Width: width, Height: height,
-			LoadingString: WorkflowDetailsPage.LoadingString(),
-			CopySavePath:  copySavePath, SelectionEnabled: false, WrapText: true, RequestInput: false,
-		},
+			FilterPrefix: info.filterPrefix, LoadingString: p.LoadingString(),
+			CopySavePath: copySavePath, SelectionEnabled: info.kind == listPage, WrapText: info.kind.wrapsText(), RequestInput: false,
+			ViewportConditionalStyle: info.viewportConditionalStyle,
+			MarkingEnabled:           info.markingEnabled,
+		}
 	}
+	return configs
+}
+
+func (k pageKind) wrapsText() bool {
+	return k == detailsPage || k == formPage || k == actionPage
+}
+
+func (k pageKind) takesInput() bool {
+	return k == formPage || k == actionPage
 }
 
 func (p Page) DoesLoad() bool {
-	noLoadPages := []Page{}
-	for _, noLoadPage := range noLoadPages {
-		if noLoadPage == p {
-			return false
-		}
-	}
-	return true
+	return !pageInfos[p].kind.takesInput()
 }
 
 func (p Page) DoesReload() bool {
-	noReloadPages := []Page{}
-	for _, noReloadPage := range noReloadPages {
-		if noReloadPage == p {
-			return false
-		}
-	}
-	return true
+	return !pageInfos[p].kind.takesInput()
 }
 
 func (p Page) doesUpdate() bool {
-	noUpdatePages := []Page{
-		// LoglinePage,     // doesn't load
-		// ExecPage,        // doesn't reload
-		// LogsPage,        // currently makes scrolling impossible - solve in https://github.com/robinovitch61/wander/issues/1
-		// JobSpecPage,     // would require changes to make scrolling possible
-		// AllocSpecPage,   // would require changes to make scrolling possible
-		// JobEventsPage,   // constant connection, streams data
-		// JobEventPage,    // doesn't load
-		// AllocEventsPage, // constant connection, streams data
-		// AllocEventPage,  // doesn't load
-		// AllEventsPage,   // constant connection, streams data
-		// AllEventPage,    // doesn't load
-	}
-	for _, noUpdatePage := range noUpdatePages {
-		if noUpdatePage == p {
-			return false
-		}
-	}
-	return true
+	info := pageInfos[p]
+	return !info.kind.takesInput() && !info.static
+}
+
+// ChangesServerState is true of the pages of actions that change workflows, batches, activities or schedules, refused in read-only mode
+func (p Page) ChangesServerState() bool {
+	return pageInfos[p].kind == actionPage
 }
 
 func (p Page) String() string {
@@ -94,11 +184,88 @@ func (p Page) String() string {
 		return "workflow details"
 	case WorkflowTermPage:
 		return "workflow termination"
+	case WorkflowCancelPage:
+		return "workflow cancellation"
+	case WorkflowSignalPage:
+		return "workflow signal"
+	case WorkflowStartPage:
+		return "workflow start"
+	case WorkflowSignalWithStartPage:
+		return "signal with start"
+	case WorkflowHistoryPage:
+		return "history"
+	case HistoryEventPage:
+		return "event"
+	case HistoryTimelinePage:
+		return "timeline"
+	case WorkflowDiffPage:
+		return "history diff"
+	case WorkflowResetPointsPage:
+		return "reset points"
+	case WorkflowResetPage:
+		return "workflow reset"
+	case WorkflowsQueryPage:
+		return "workflows query"
+	case WorkflowBatchPage:
+		return "batch operation"
+	case BatchOperationPage:
+		return "batch progress"
+	case BatchOperationsPage:
+		return "batch operations"
+	case BatchStopPage:
+		return "batch stop"
+	case WorkflowDeletePage:
+		return "workflow deletion"
+	case WorkflowUpdatePage:
+		return "workflow update"
+	case WorkflowUpdatesPage:
+		return "updates"
+	case WorkflowUpdateResultPage:
+		return "update result"
+	case WorkflowUpdateAttachPage:
+		return "update re-attach"
+	case PendingActivitiesPage:
+		return "pending activities"
+	case ActivityCompletePage:
+		return "activity completion"
+	case ActivityFailPage:
+		return "activity failure"
+	case ActivityHeartbeatPage:
+		return "activity heartbeat"
+	case SchedulesPage:
+		return "schedules"
+	case ScheduleDetailsPage:
+		return "schedule details"
+	case SchedulePausePage:
+		return "schedule pause"
+	case ScheduleUnpausePage:
+		return "schedule unpause"
+	case ScheduleTriggerPage:
+		return "schedule trigger"
+	case ScheduleBackfillPage:
+		return "schedule backfill"
+	case ScheduleDeletePage:
+		return "schedule deletion"
+	case ScheduleCreatePage:
+		return "schedule creation"
+	case ScheduleEditPage:
+		return "schedule edit"
+	case TaskQueueSelectPage:
+		return "task queue selection"
+	case TaskQueuePage:
+		return "task queue"
+	case BuildIDsPage:
+		return "build IDs"
+	case NamespacesPage:
+		return "namespaces"
 	}
 	return "unknown"
 }
 
 func (p Page) LoadingString() string {
+	if !p.DoesLoad() {
+		return fmt.Sprintf("Submitting %s...", p.String())
+	}
 	return fmt.Sprintf("Loading %s...", p.String())
 }
 
@@ -106,6 +273,22 @@ func (p Page) Forward() Page {
 	switch p {
 	case WorkflowsPage:
 		return WorkflowDetailsPage
+	case WorkflowDetailsPage:
+		return WorkflowHistoryPage
+	case WorkflowHistoryPage, HistoryTimelinePage:
+		return HistoryEventPage
+	case WorkflowResetPointsPage:
+		return WorkflowResetPage
+	case BatchOperationsPage:
+		return BatchOperationPage
+	case WorkflowUpdatesPage:
+		return WorkflowUpdateResultPage
+	case SchedulesPage:
+		return ScheduleDetailsPage
+	case ScheduleDetailsPage:
+		return WorkflowDetailsPage
+	case NamespacesPage:
+		return WorkflowsPage
 	}
 	return p
 }
@@ -113,12 +296,40 @@ func (p Page) Forward() Page {
 func (p Page) Backward() Page {
 	switch p {
 	case WorkflowDetailsPage:
+		return WorkflowsPage
+	case WorkflowHistoryPage:
+		return WorkflowDetailsPage
+	case HistoryEventPage, HistoryTimelinePage:
+		return WorkflowHistoryPage
+	case WorkflowDiffPage:
+		return WorkflowsPage
+	case WorkflowResetPointsPage:
+		return WorkflowDetailsPage
+	case BatchOperationPage:
+		return BatchOperationsPage
+	case BatchOperationsPage:
+		return WorkflowsPage
+	case WorkflowUpdatesPage:
+		return WorkflowsPage
+	case WorkflowUpdateResultPage:
+		return WorkflowUpdatesPage
+	case PendingActivitiesPage:
 		return WorkflowDetailsPage
+	case SchedulesPage:
+		return WorkflowsPage
+	case ScheduleDetailsPage:
+		return SchedulesPage
+	case TaskQueuePage:
+		return WorkflowsPage
+	case BuildIDsPage:
+		return TaskQueuePage
+	case NamespacesPage:
+		return WorkflowsPage
 	}
 	return p
 }
 
-func (p Page) GetFilterPrefix(workflowID string) string {
+func (p Page) GetFilterPrefix(workflowID string, eventID int64) string {
 	switch p {
 	case WorkflowsPage:
 		return "Workflow"
@@ -126,6 +337,80 @@ func (p Page) GetFilterPrefix(workflowID string) string {
 		return fmt.Sprintf("Workflow Details for %s", style.Bold.Render(workflowID))
 	case WorkflowTermPage:
 		return fmt.Sprintf("Workflow Termination for %s", style.Bold.Render(workflowID))
+	case WorkflowCancelPage:
+		return fmt.Sprintf("Workflow Cancellation for %s", style.Bold.Render(workflowID))
+	case WorkflowSignalPage:
+		return fmt.Sprintf("Signal %s", style.Bold.Render(workflowID))
+	case WorkflowStartPage:
+		return "Start Workflow"
+	case WorkflowSignalWithStartPage:
+		return "Signal With Start Workflow"
+	case WorkflowHistoryPage:
+		return fmt.Sprintf("History for %s", style.Bold.Render(workflowID))
+	case HistoryEventPage:
+		return fmt.Sprintf("Event %d for %s", eventID, style.Bold.Render(workflowID))
+	case HistoryTimelinePage:
+		return fmt.Sprintf("Timeline for %s", style.Bold.Render(workflowID))
+	case WorkflowDiffPage:
+		return fmt.Sprintf("History Diff of %s", workflowID)
+	case WorkflowResetPointsPage:
+		return fmt.Sprintf("Reset Points for %s", style.Bold.Render(workflowID))
+	case WorkflowResetPage:
+		return fmt.Sprintf("Workflow Reset for %s", style.Bold.Render(workflowID))
+	case WorkflowsQueryPage:
+		return "Workflows Query"
+	case WorkflowBatchPage:
+		return fmt.Sprintf("Batch Operation on %s", style.Bold.Render(workflowID))
+	case BatchOperationPage:
+		return fmt.Sprintf("Batch Operation %s", style.Bold.Render(workflowID))
+	case BatchOperationsPage:
+		return "Batch Operations"
+	case BatchStopPage:
+		return fmt.Sprintf("Stop Batch Operation %s", style.Bold.Render(workflowID))
+	case WorkflowDeletePage:
+		return fmt.Sprintf("Workflow Deletion for %s", style.Bold.Render(workflowID))
+	case WorkflowUpdatePage:
+		return fmt.Sprintf("Update %s", style.Bold.Render(workflowID))
+	case WorkflowUpdatesPage:
+		return "Updates of This Session"
+	case WorkflowUpdateResultPage:
+		return fmt.Sprintf("Update Result for %s", style.Bold.Render(workflowID))
+	case WorkflowUpdateAttachPage:
+		return "Re-attach to Update"
+	case PendingActivitiesPage:
+		return fmt.Sprintf("Pending Activities for %s", style.Bold.Render(workflowID))
+	case ActivityCompletePage:
+		return fmt.Sprintf("Complete Activity of %s", style.Bold.Render(workflowID))
+	case ActivityFailPage:
+		return fmt.Sprintf("Fail Activity of %s", style.Bold.Render(workflowID))
+	case ActivityHeartbeatPage:
+		return fmt.Sprintf("Heartbeat Activity of %s", style.Bold.Render(workflowID))
+	case SchedulesPage:
+		return "Schedules"
+	case ScheduleDetailsPage:
+		return fmt.Sprintf("Schedule %s", style.Bold.Render(workflowID))
+	case SchedulePausePage:
+		return fmt.Sprintf("Pause Schedule %s", style.Bold.Render(workflowID))
+	case ScheduleUnpausePage:
+		return fmt.Sprintf("Unpause Schedule %s", style.Bold.Render(workflowID))
+	case ScheduleTriggerPage:
+		return fmt.Sprintf("Trigger Schedule %s", style.Bold.Render(workflowID))
+	case ScheduleBackfillPage:
+		return fmt.Sprintf("Backfill Schedule %s", style.Bold.Render(workflowID))
+	case ScheduleDeletePage:
+		return fmt.Sprintf("Schedule Deletion for %s", style.Bold.Render(workflowID))
+	case ScheduleCreatePage:
+		return "Create Schedule"
+	case ScheduleEditPage:
+		return fmt.Sprintf("Edit Schedule %s", style.Bold.Render(workflowID))
+	case TaskQueueSelectPage:
+		return "Task Queue"
+	case TaskQueuePage:
+		return fmt.Sprintf("Pollers of Task Queue %s", style.Bold.Render(workflowID))
+	case BuildIDsPage:
+		return fmt.Sprintf("Build IDs of Task Queue %s", style.Bold.Render(workflowID))
+	case NamespacesPage:
+		return "Namespaces"
 	default:
 		panic("page not found")
 	}
@@ -135,6 +420,21 @@ type PageLoadedMsg struct {
 	Page        Page
 	TableHeader []string
 	AllPageRows []page.Row
+	// Err, if set, is why some rows are incomplete, shown in a toast when the page is loaded rather than updated
+	Err error
+}
+
+// ActionCompletedMsg reports the outcome of a page that changes server state, e.g. a workflow reset
+type ActionCompletedMsg struct {
+	Page    Page
+	Message string
+	Err     error
+	// Workflow, if set, is a workflow the action started, whose details are shown next
+	Workflow WorkflowKey
+	// BatchJobID, if set, is a batch operation the action started, whose progress is shown next
+	BatchJobID string
+	// ScheduleID, if set, is a schedule the action created, whose details are shown next
+	ScheduleID string
 }
 
 type UpdatePageDataMsg struct {
@@ -162,7 +462,32 @@ func changeKeyHelp(k *key.Binding, h string) {
 	k.SetHelp(k.Help().Key, h)
 }
 
-func GetPageKeyHelp(currentPage Page, filterFocused, filterApplied, saving, enteringInput bool) string {
+// withoutMutatingKeys hides the keys of actions that change server state
+func withoutMutatingKeys(bindings []key.Binding) []key.Binding {
+	mutatingKeys := []key.Binding{
+		keymap.KeyMap.Term, keymap.KeyMap.Cancel, keymap.KeyMap.Signal, keymap.KeyMap.SigStart, keymap.KeyMap.Start,
+		keymap.KeyMap.Rerun, keymap.KeyMap.Reset, keymap.KeyMap.Delete, keymap.KeyMap.Batch, keymap.KeyMap.Stop,
+		keymap.KeyMap.Update, keymap.KeyMap.Complete, keymap.KeyMap.Fail, keymap.KeyMap.Heartbeat,
+		keymap.KeyMap.Pause, keymap.KeyMap.Trigger, keymap.KeyMap.Backfill, keymap.KeyMap.Create, keymap.KeyMap.Edit,
+	}
+	var kept []key.Binding
+	for _, binding := range bindings {
+		mutating := false
+		for _, mutatingKey := range mutatingKeys {
+			// compared by description too, as page-scoped keys share letters
+			if binding.Help() == mutatingKey.Help() {
+				mutating = true
+				break
+			}
+		}
+		if !mutating {
+			kept = append(kept, binding)
+		}
+	}
+	return kept
+}
+
+func GetPageKeyHelp(currentPage Page, filterFocused, filterApplied, saving, enteringInput, offline, readOnly bool) string {
 	firstRow := []key.Binding{keymap.KeyMap.Exit}
 
 	if currentPage.DoesReload() && !saving && !filterFocused {
@@ -182,13 +507,60 @@ func GetPageKeyHelp(currentPage Page, filterFocused, filterApplied, saving, ente
 	if filterApplied {
 		changeKeyHelp(&keymap.KeyMap.Back, "remove filter")
 		fourthRow = append(fourthRow, keymap.KeyMap.Back)
-	} else if prevPage := currentPage.Backward(); prevPage != currentPage {
+	} else if prevPage := currentPage.Backward(); prevPage != currentPage && !(offline && prevPage == WorkflowDetailsPage) {
 		changeKeyHelp(&keymap.KeyMap.Back, fmt.Sprintf("%s", currentPage.Backward().String()))
 		fourthRow = append(fourthRow, keymap.KeyMap.Back)
+	} else if !currentPage.DoesLoad() {
+		// the results of an action on several workflows
+		changeKeyHelp(&keymap.KeyMap.Back, "back")
+		fourthRow = append(fourthRow, keymap.KeyMap.Back)
 	}
 
 	if currentPage == WorkflowsPage {
-		fourthRow = append(fourthRow, keymap.KeyMap.Term)
+		fourthRow = append(fourthRow, keymap.KeyMap.Mark, keymap.KeyMap.MarkAll, keymap.KeyMap.Invert, keymap.KeyMap.Term, keymap.KeyMap.Delete, keymap.KeyMap.Diff, keymap.KeyMap.Query, keymap.KeyMap.Batch, keymap.KeyMap.Batches, keymap.KeyMap.Updates, keymap.KeyMap.Schedules, keymap.KeyMap.Task, keymap.KeyMap.Namespaces)
+	}
+
+	if currentPage == WorkflowsPage || currentPage == WorkflowDetailsPage {
+		fourthRow = append(fourthRow, keymap.KeyMap.Start, keymap.KeyMap.Cancel, keymap.KeyMap.Signal, keymap.KeyMap.SigStart, keymap.KeyMap.Update, keymap.KeyMap.Rerun, keymap.KeyMap.Reset)
+	}
+
+	if currentPage == BatchOperationsPage || currentPage == BatchOperationPage {
+		fourthRow = append(fourthRow, keymap.KeyMap.Stop)
+	}
+
+	if currentPage == WorkflowsPage || currentPage == WorkflowDetailsPage {
+		fourthRow = append(fourthRow, keymap.KeyMap.Queue)
+	}
+
+	if currentPage == WorkflowDetailsPage {
+		fourthRow = append(fourthRow, keymap.KeyMap.Activities)
+	}
+
+	if currentPage == TaskQueuePage {
+		fourthRow = append(fourthRow, keymap.KeyMap.Running, keymap.KeyMap.Versions)
+	}
+
+	if currentPage == WorkflowUpdatesPage {
+		fourthRow = append(fourthRow, keymap.KeyMap.Attach)
+	}
+
+	if currentPage == PendingActivitiesPage {
+		fourthRow = append(fourthRow, keymap.KeyMap.Complete, keymap.KeyMap.Fail, keymap.KeyMap.Heartbeat)
+	}
+
+	if currentPage == SchedulesPage || currentPage == ScheduleDetailsPage {
+		fourthRow = append(fourthRow, keymap.KeyMap.Create, keymap.KeyMap.Edit, keymap.KeyMap.Pause, keymap.KeyMap.Trigger, keymap.KeyMap.Backfill, keymap.KeyMap.Delete)
+	}
+
+	if currentPage == WorkflowHistoryPage {
+		fourthRow = append(fourthRow, keymap.KeyMap.Timeline)
+		if !offline {
+			fourthRow = append(fourthRow, keymap.KeyMap.Reset)
+		}
+	}
+
+	if readOnly {
+		fourthRow = withoutMutatingKeys(fourthRow)
 	}
 
 	if saving {
@@ -198,6 +570,14 @@ func GetPageKeyHelp(currentPage Page, filterFocused, filterApplied, saving, ente
 		return getShortHelp(firstRow) + "\n" + getShortHelp(secondRow)
 	}
 
+	if enteringInput {
+		formKeyMap := form.GetKeyMap()
+		firstRow = []key.Binding{keymap.KeyMap.Exit}
+		firstRow[0].SetHelp("ctrl+c", "exit")
+		secondRow = []key.Binding{formKeyMap.Next, formKeyMap.Prev, formKeyMap.Enter, formKeyMap.Submit, formKeyMap.Toggle, formKeyMap.Suggest, formKeyMap.Cancel}
+		return getShortHelp(firstRow) + "\n" + getShortHelp(secondRow)
+	}
+
 	if filterFocused {
 		changeKeyHelp(&keymap.KeyMap.Forward, "apply filter")
 		changeKeyHelp(&keymap.KeyMap.Back, "cancel filter")
@@ -0,0 +1,68 @@
+package temporaltui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+)
+
+// WorkflowsQueryMsg reports a visibility query checked against the server, listed on the Workflows page if Err is nil
+type WorkflowsQueryMsg struct {
+	Query string
+	Count int64
+	Err   error
+}
+
+func WorkflowsQueryForm(query string) form.Model {
+	return form.New(form.Config{
+		Title: "Workflows Query",
+		Info: []string{
+			"Lists the workflows matching a visibility query, e.g.",
+			`  WorkflowType="order" AND ExecutionStatus="Running"`,
+			"Leave empty to list all workflows.",
+		},
+		Fields: []form.Field{
+			{Key: "query", Label: "Query", Placeholder: "visibility query", Value: query},
+		},
+	})
+}
+
+// SetWorkflowsQuery counts the workflows matching query, so that a query the server rejects is reported before it is listed
+func SetWorkflowsQuery(client temporalClient.Client, query string) tea.Cmd {
+	query = strings.TrimSpace(query)
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		count, err := countWorkflows(ctx, client, query)
+		if err != nil {
+			return WorkflowsQueryMsg{Query: query, Err: fmt.Errorf("invalid query: %w", err)}
+		}
+		return WorkflowsQueryMsg{Query: query, Count: count}
+	}
+}
+
+// Completed summarizes the outcome of setting the query
+func (msg WorkflowsQueryMsg) Completed() ActionCompletedMsg {
+	if msg.Err != nil {
+		return ActionCompletedMsg{Page: WorkflowsQueryPage, Err: msg.Err}
+	}
+	if msg.Query == "" {
+		return ActionCompletedMsg{Page: WorkflowsQueryPage, Message: fmt.Sprintf("Listing all %d workflows", msg.Count)}
+	}
+	return ActionCompletedMsg{Page: WorkflowsQueryPage, Message: fmt.Sprintf("%d workflows match %s", msg.Count, msg.Query)}
+}
+
+func countWorkflows(ctx context.Context, client temporalClient.Client, query string) (int64, error) {
+	resp, err := client.CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{Query: query})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetCount(), nil
+}
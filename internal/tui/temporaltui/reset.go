@@ -0,0 +1,149 @@
+package temporaltui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+	"github.com/neomantra/tempted/internal/tui/message"
+)
+
+// ResetTarget is a workflow run and the WorkflowTaskCompleted event to reset it to.
+type ResetTarget struct {
+	Key     WorkflowKey
+	EventID int64
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+func FetchWorkflowResetPoints(key WorkflowKey, client temporalClient.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		resp, err := client.DescribeWorkflowExecution(ctx, key.WorkflowID, key.RunID)
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+
+		tableHeader, allPageData := resetPointsAsTable(resp.GetWorkflowExecutionInfo().GetAutoResetPoints().GetPoints())
+		return PageLoadedMsg{
+			Page:        WorkflowResetPointsPage,
+			TableHeader: tableHeader,
+			AllPageRows: allPageData,
+		}
+	}
+}
+
+func ResetWorkflowForm(target ResetTarget) form.Model {
+	return form.New(form.Config{
+		Title: "Reset Workflow",
+		Info: []string{
+			fmt.Sprintf("Workflow ID: %s", target.Key.WorkflowID),
+			fmt.Sprintf("Run ID:      %s", target.Key.RunID),
+			fmt.Sprintf("Reset to:    event %d (WorkflowTaskCompleted)", target.EventID),
+		},
+		Fields: []form.Field{
+			{Key: "reason", Label: "Reason", Placeholder: "why the workflow is being reset"},
+			{Key: "reapplySignals", Label: "Reapply signals", Value: "true", Kind: form.Toggle},
+		},
+		Validate: func(v form.Values) error {
+			if strings.TrimSpace(v["reason"]) == "" {
+				return errors.New("a reason is required")
+			}
+			return nil
+		},
+		Confirm: true,
+	})
+}
+
+func ResetWorkflow(client temporalClient.Client, namespace string, target ResetTarget, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		reapplyType := enumspb.RESET_REAPPLY_TYPE_NONE
+		if values.Bool("reapplySignals") {
+			reapplyType = enumspb.RESET_REAPPLY_TYPE_SIGNAL
+		}
+
+		resp, err := client.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace: namespace,
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: target.Key.WorkflowID,
+				RunId:      target.Key.RunID,
+			},
+			Reason:                    values["reason"],
+			WorkflowTaskFinishEventId: target.EventID,
+			RequestId:                 uuid.New().String(),
+			ResetReapplyType:          reapplyType,
+		})
+		if err != nil {
+			return ActionCompletedMsg{Page: WorkflowResetPage, Err: err}
+		}
+		return ActionCompletedMsg{
+			Page:    WorkflowResetPage,
+			Message: fmt.Sprintf("Reset %s to event %d, new run %s", target.Key.WorkflowID, target.EventID, resp.GetRunId()),
+		}
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+func resetPointsAsTable(points []*workflowpb.ResetPointInfo) ([]string, []page.Row) {
+	var resetPointRows [][]string
+	var keys []string
+	for _, point := range points {
+		resetPointRows = append(resetPointRows, []string{
+			point.BinaryChecksum,
+			point.RunId,
+			strconv.FormatInt(point.FirstWorkflowTaskCompletedId, 10),
+			formatter.FormatTimePtr(point.CreateTime),
+			formatter.FormatTimePtr(point.ExpireTime),
+			strconv.FormatBool(point.Resettable),
+		})
+		keys = append(keys, formatResetPointKey(point))
+	}
+
+	columns := []string{"Binary Checksum / Build ID", "Run ID", "Event ID", "Create Time", "Expire Time", "Resettable"}
+	table := formatter.GetRenderedTableAsString(columns, resetPointRows)
+
+	var rows []page.Row
+	for idx, row := range table.ContentRows {
+		rows = append(rows, page.Row{Key: keys[idx], Row: row})
+	}
+
+	return table.HeaderRows, rows
+}
+
+func formatResetPointKey(point *workflowpb.ResetPointInfo) string {
+	return fmt.Sprintf("%s %d %t", point.RunId, point.FirstWorkflowTaskCompletedId, point.Resettable)
+}
+
+// ResetPointResettableFromKey is whether the server allows a reset to the reset point of a row on the reset points page
+func ResetPointResettableFromKey(key string) bool {
+	split := strings.Split(key, " ")
+	resettable, _ := strconv.ParseBool(split[len(split)-1])
+	return resettable
+}
+
+// ResetTargetFromResetPointKey returns the ResetTarget of a row on the reset points page of workflowID.
+func ResetTargetFromResetPointKey(workflowID, key string) ResetTarget {
+	split := strings.Split(key, " ")
+	eventID, _ := strconv.ParseInt(split[1], 10, 64)
+	return ResetTarget{Key: WorkflowKey{WorkflowID: workflowID, RunID: split[0]}, EventID: eventID}
+}
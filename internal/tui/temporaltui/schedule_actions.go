@@ -0,0 +1,217 @@
+package temporaltui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	enumspb "go.temporal.io/api/enums/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+)
+
+// scheduleOverlapPolicies are the names of the overlap policies, as suggested in forms
+var scheduleOverlapPolicies = []string{"Skip", "BufferOne", "BufferAll", "CancelOther", "TerminateOther", "AllowAll"}
+
+// FetchSchedulePauseForm asks for a note to pause the schedule, or to unpause it if it is paused
+func FetchSchedulePauseForm(client temporalClient.Client, scheduleID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		desc, err := client.ScheduleClient().GetHandle(ctx, scheduleID).Describe(ctx)
+		if err != nil {
+			return FormMsg{Err: err}
+		}
+		if desc.Schedule.State != nil && desc.Schedule.State.Paused {
+			return FormMsg{Page: ScheduleUnpausePage, Form: SchedulePauseForm("Unpause Schedule", scheduleID, desc.Schedule.State.Note)}
+		}
+		return FormMsg{Page: SchedulePausePage, Form: SchedulePauseForm("Pause Schedule", scheduleID, "")}
+	}
+}
+
+// SchedulePauseForm asks for the note recorded with a pause or unpause, showing the note of the current pause
+func SchedulePauseForm(title, scheduleID, pausedNote string) form.Model {
+	info := []string{fmt.Sprintf("Schedule ID: %s", scheduleID)}
+	if pausedNote != "" {
+		info = append(info, fmt.Sprintf("Paused with note: %s", pausedNote))
+	}
+	return form.New(form.Config{
+		Title: title,
+		Info:  info,
+		Fields: []form.Field{
+			{Key: "note", Label: "Note", Placeholder: "optional, why the schedule is paused or unpaused"},
+		},
+		Confirm: true,
+	})
+}
+
+func ScheduleTriggerForm(scheduleID string) form.Model {
+	return form.New(form.Config{
+		Title: "Trigger Schedule",
+		Info: []string{
+			fmt.Sprintf("Schedule ID: %s", scheduleID),
+			"",
+			"Takes the schedule's action now, regardless of its spec.",
+		},
+		Fields: []form.Field{
+			{Key: "overlap", Label: "Overlap policy", Placeholder: "optional, the schedule's policy if empty", Suggestions: scheduleOverlapPolicies},
+		},
+		Validate: func(v form.Values) error {
+			_, err := parseScheduleOverlapPolicy(v["overlap"])
+			return err
+		},
+		Confirm: true,
+	})
+}
+
+func ScheduleBackfillForm(scheduleID string) form.Model {
+	return form.New(form.Config{
+		Title: "Backfill Schedule",
+		Info: []string{
+			fmt.Sprintf("Schedule ID: %s", scheduleID),
+			"",
+			"Takes the actions the schedule's spec would have taken between the start and end times.",
+		},
+		Fields: []form.Field{
+			{Key: "start", Label: "Start time", Placeholder: "RFC 3339, e.g. 2023-04-20T00:00:00Z"},
+			{Key: "end", Label: "End time", Placeholder: "RFC 3339, e.g. 2023-04-21T00:00:00Z"},
+			{Key: "overlap", Label: "Overlap policy", Placeholder: "optional, the schedule's policy if empty", Suggestions: scheduleOverlapPolicies},
+		},
+		Validate: func(v form.Values) error {
+			_, err := scheduleBackfillFromValues(v)
+			return err
+		},
+		Confirm: true,
+	})
+}
+
+func ScheduleDeleteForm(scheduleID string) form.Model {
+	// retyping the schedule ID guards against deleting the wrong one
+	return form.New(form.Config{
+		Title: "Delete Schedule",
+		Info: []string{
+			fmt.Sprintf("Schedule ID: %s", scheduleID),
+			"",
+			"Deletion stops the schedule from taking actions. Workflows it started are not affected. It cannot be undone.",
+		},
+		Confirm:     true,
+		ConfirmText: scheduleID,
+	})
+}
+
+func PauseSchedule(client temporalClient.Client, scheduleID string, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := client.ScheduleClient().GetHandle(ctx, scheduleID).Pause(ctx, temporalClient.SchedulePauseOptions{
+			Note: strings.TrimSpace(values["note"]),
+		})
+		if err != nil {
+			return ActionCompletedMsg{Page: SchedulePausePage, Err: err}
+		}
+		return ActionCompletedMsg{Page: SchedulePausePage, Message: fmt.Sprintf("Paused schedule %s", scheduleID)}
+	}
+}
+
+func UnpauseSchedule(client temporalClient.Client, scheduleID string, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := client.ScheduleClient().GetHandle(ctx, scheduleID).Unpause(ctx, temporalClient.ScheduleUnpauseOptions{
+			Note: strings.TrimSpace(values["note"]),
+		})
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleUnpausePage, Err: err}
+		}
+		return ActionCompletedMsg{Page: ScheduleUnpausePage, Message: fmt.Sprintf("Unpaused schedule %s", scheduleID)}
+	}
+}
+
+func TriggerSchedule(client temporalClient.Client, scheduleID string, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		overlap, err := parseScheduleOverlapPolicy(values["overlap"])
+		if err == nil {
+			err = client.ScheduleClient().GetHandle(ctx, scheduleID).Trigger(ctx, temporalClient.ScheduleTriggerOptions{
+				Overlap: overlap,
+			})
+		}
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleTriggerPage, Err: err}
+		}
+		return ActionCompletedMsg{Page: ScheduleTriggerPage, Message: fmt.Sprintf("Triggered schedule %s", scheduleID)}
+	}
+}
+
+func BackfillSchedule(client temporalClient.Client, scheduleID string, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		backfill, err := scheduleBackfillFromValues(values)
+		if err == nil {
+			err = client.ScheduleClient().GetHandle(ctx, scheduleID).Backfill(ctx, temporalClient.ScheduleBackfillOptions{
+				Backfill: []temporalClient.ScheduleBackfill{backfill},
+			})
+		}
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleBackfillPage, Err: err}
+		}
+		return ActionCompletedMsg{Page: ScheduleBackfillPage, Message: fmt.Sprintf("Backfilled schedule %s from %s to %s", scheduleID, backfill.Start.Format(time.RFC3339), backfill.End.Format(time.RFC3339))}
+	}
+}
+
+func DeleteSchedule(client temporalClient.Client, scheduleID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		if err := client.ScheduleClient().GetHandle(ctx, scheduleID).Delete(ctx); err != nil {
+			return ActionCompletedMsg{Page: ScheduleDeletePage, Err: err}
+		}
+		return ActionCompletedMsg{Page: ScheduleDeletePage, Message: fmt.Sprintf("Deleted schedule %s", scheduleID)}
+	}
+}
+
+// parseScheduleOverlapPolicy reads the name of an overlap policy, unspecified if empty
+func parseScheduleOverlapPolicy(s string) (enumspb.ScheduleOverlapPolicy, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, nil
+	}
+	for _, policy := range scheduleOverlapPolicies {
+		if strings.EqualFold(s, policy) {
+			return enumspb.ScheduleOverlapPolicy(enumspb.ScheduleOverlapPolicy_value[policy]), nil
+		}
+	}
+	return enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, fmt.Errorf("overlap policy must be one of %s", strings.Join(scheduleOverlapPolicies, ", "))
+}
+
+func scheduleBackfillFromValues(v form.Values) (temporalClient.ScheduleBackfill, error) {
+	var backfill temporalClient.ScheduleBackfill
+	start, err := time.Parse(time.RFC3339, strings.TrimSpace(v["start"]))
+	if err != nil {
+		return backfill, fmt.Errorf("invalid start time: %w", err)
+	}
+	end, err := time.Parse(time.RFC3339, strings.TrimSpace(v["end"]))
+	if err != nil {
+		return backfill, fmt.Errorf("invalid end time: %w", err)
+	}
+	if !end.After(start) {
+		return backfill, errors.New("the end time must be after the start time")
+	}
+	overlap, err := parseScheduleOverlapPolicy(v["overlap"])
+	if err != nil {
+		return backfill, err
+	}
+	return temporalClient.ScheduleBackfill{Start: start, End: end, Overlap: overlap}, nil
+}
@@ -0,0 +1,318 @@
+package temporaltui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/robfig/cron"
+	commonpb "go.temporal.io/api/common/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+)
+
+// schedulePreviewCount is how many upcoming fire times the schedule form previews
+const schedulePreviewCount = 5
+
+// ScheduleParams pre-fill the schedule form
+type ScheduleParams struct {
+	ID, Spec, TimeZone, WorkflowType, TaskQueue, Args, Overlap, Jitter string
+}
+
+func CreateScheduleForm() form.Model {
+	return scheduleForm("Create Schedule", nil, ScheduleParams{}, true)
+}
+
+// FetchEditScheduleForm pre-fills the schedule form from the schedule's description
+func FetchEditScheduleForm(client temporalClient.Client, scheduleID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		desc, err := client.ScheduleClient().GetHandle(ctx, scheduleID).Describe(ctx)
+		if err != nil {
+			return FormMsg{Err: err}
+		}
+
+		params := ScheduleParams{ID: scheduleID}
+		info := []string{fmt.Sprintf("Schedule ID: %s", scheduleID)}
+		if spec := desc.Schedule.Spec; spec != nil {
+			var entries []string
+			entries, params.Spec = scheduleSpecEntries(spec)
+			if len(entries) > 1 {
+				info = append(info, "", fmt.Sprintf("The spec has %d entries, %s. Submitting replaces them with the one entered.", len(entries), strings.Join(entries, "; ")))
+			} else if entries[0] != params.Spec {
+				info = append(info, "", fmt.Sprintf("The spec is %s, shown as a 5-field cron expression. Submitting replaces it with the one entered.", entries[0]))
+			}
+			params.TimeZone = spec.TimeZoneName
+			params.Jitter = formatOptionalDuration(spec.Jitter)
+		}
+		if policy := desc.Schedule.Policy; policy != nil && policy.Overlap != 0 {
+			params.Overlap = policy.Overlap.String()
+		}
+		if workflow, ok := desc.Schedule.Action.(*temporalClient.ScheduleWorkflowAction); ok {
+			params.WorkflowType = fmt.Sprint(workflow.Workflow)
+			params.TaskQueue = workflow.TaskQueue
+			var payloads []*commonpb.Payload
+			for _, arg := range workflow.Args {
+				if p, ok := arg.(*commonpb.Payload); ok {
+					payloads = append(payloads, p)
+				}
+			}
+			if params.Args, err = payloadsAsJSON(payloads); err != nil {
+				return FormMsg{Err: err}
+			}
+		}
+		return FormMsg{Page: ScheduleEditPage, Form: scheduleForm("Edit Schedule", info, params, false)}
+	}
+}
+
+func scheduleForm(title string, info []string, params ScheduleParams, withID bool) form.Model {
+	var fields []form.Field
+	if withID {
+		fields = append(fields, form.Field{Key: "id", Label: "Schedule ID", Value: params.ID})
+	}
+	fields = append(fields,
+		form.Field{Key: "spec", Label: "Spec", Placeholder: "5-field cron, e.g. 0 9 * * 1-5, or interval, e.g. 1h or 1h offset 15m", Value: params.Spec},
+		form.Field{Key: "timeZone", Label: "Time zone", Placeholder: "optional, e.g. America/New_York, UTC if empty", Value: params.TimeZone},
+		form.Field{Key: "type", Label: "Workflow type", Value: params.WorkflowType},
+		form.Field{Key: "taskQueue", Label: "Task queue", Value: params.TaskQueue},
+		form.Field{Key: "args", Label: "JSON args", Placeholder: "optional JSON array, e.g. [\"arg1\", 2]", Value: params.Args, Kind: form.MultiLine},
+		form.Field{Key: "overlap", Label: "Overlap policy", Placeholder: "optional, Skip if empty", Value: params.Overlap, Suggestions: scheduleOverlapPolicies},
+		form.Field{Key: "jitter", Label: "Jitter", Placeholder: "optional maximum random delay, e.g. 30s", Value: params.Jitter},
+	)
+	return form.New(form.Config{
+		Title:  title,
+		Info:   info,
+		Fields: fields,
+		Validate: func(v form.Values) error {
+			if withID && strings.TrimSpace(v["id"]) == "" {
+				return errors.New("a schedule ID is required")
+			}
+			if strings.TrimSpace(v["type"]) == "" {
+				return errors.New("a workflow type is required")
+			}
+			if strings.TrimSpace(v["taskQueue"]) == "" {
+				return errors.New("a task queue is required")
+			}
+			if _, err := parseJSONArgs(v["args"]); err != nil {
+				return err
+			}
+			if _, err := parseScheduleOverlapPolicy(v["overlap"]); err != nil {
+				return err
+			}
+			_, err := scheduleSpecFromValues(v)
+			return err
+		},
+		Preview: func(v form.Values) []string {
+			spec, err := scheduleSpecFromValues(v)
+			if err != nil {
+				return nil
+			}
+			lines := []string{"Next fire times in local time, before jitter:"}
+			for _, t := range nextScheduleTimes(spec, time.Now(), schedulePreviewCount) {
+				line := "  " + formatter.FormatTime(t)
+				if spec.TimeZoneName != "" {
+					line += fmt.Sprintf(" (%s)", t.Format("Mon 15:04 MST"))
+				}
+				lines = append(lines, line)
+			}
+			return lines
+		},
+		Confirm: true,
+	})
+}
+
+func CreateSchedule(client temporalClient.Client, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		scheduleID := strings.TrimSpace(values["id"])
+		spec, err := scheduleSpecFromValues(values)
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleCreatePage, Err: err}
+		}
+		args, err := parseJSONArgs(values["args"])
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleCreatePage, Err: err}
+		}
+		overlap, err := parseScheduleOverlapPolicy(values["overlap"])
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleCreatePage, Err: err}
+		}
+
+		_, err = client.ScheduleClient().Create(ctx, temporalClient.ScheduleOptions{
+			ID:   scheduleID,
+			Spec: spec.ScheduleSpec,
+			Action: &temporalClient.ScheduleWorkflowAction{
+				ID:        scheduleID,
+				Workflow:  strings.TrimSpace(values["type"]),
+				Args:      args,
+				TaskQueue: strings.TrimSpace(values["taskQueue"]),
+			},
+			Overlap: overlap,
+		})
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleCreatePage, Err: err}
+		}
+		return ActionCompletedMsg{Page: ScheduleCreatePage, Message: fmt.Sprintf("Created schedule %s", scheduleID), ScheduleID: scheduleID}
+	}
+}
+
+// UpdateSchedule replaces the spec, workflow and overlap policy of a schedule, keeping the rest of it
+func UpdateSchedule(client temporalClient.Client, scheduleID string, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		spec, err := scheduleSpecFromValues(values)
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleEditPage, Err: err}
+		}
+		args, err := parseJSONArgs(values["args"])
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleEditPage, Err: err}
+		}
+		overlap, err := parseScheduleOverlapPolicy(values["overlap"])
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleEditPage, Err: err}
+		}
+
+		err = client.ScheduleClient().GetHandle(ctx, scheduleID).Update(ctx, temporalClient.ScheduleUpdateOptions{
+			DoUpdate: func(input temporalClient.ScheduleUpdateInput) (*temporalClient.ScheduleUpdate, error) {
+				schedule := input.Description.Schedule
+				newSpec := spec.ScheduleSpec
+				if schedule.Spec != nil {
+					newSpec.Skip, newSpec.StartAt, newSpec.EndAt = schedule.Spec.Skip, schedule.Spec.StartAt, schedule.Spec.EndAt
+				}
+				schedule.Spec = &newSpec
+
+				workflow, ok := schedule.Action.(*temporalClient.ScheduleWorkflowAction)
+				if !ok {
+					return nil, fmt.Errorf("schedule %s does not start a workflow", scheduleID)
+				}
+				workflow.Workflow = strings.TrimSpace(values["type"])
+				workflow.TaskQueue = strings.TrimSpace(values["taskQueue"])
+				workflow.Args = args
+
+				if schedule.Policy == nil {
+					// the SDK always describes policies, but nil ones would drop the overlap policy entered
+					schedule.Policy = newOf(schedule.Policy)
+				}
+				schedule.Policy.Overlap = overlap
+				return &temporalClient.ScheduleUpdate{Schedule: &schedule}, nil
+			},
+		})
+		if err != nil {
+			return ActionCompletedMsg{Page: ScheduleEditPage, Err: err}
+		}
+		return ActionCompletedMsg{Page: ScheduleEditPage, Message: fmt.Sprintf("Updated schedule %s", scheduleID)}
+	}
+}
+
+// newOf allocates the zero value of what p points to, for SDK types the client package does not export
+func newOf[T any](p *T) *T {
+	return new(T)
+}
+
+// scheduleFormSpec is the spec of the schedule form, with the cron schedule that previews its fire times if it has one
+type scheduleFormSpec struct {
+	temporalClient.ScheduleSpec
+	cron     cron.Schedule
+	location *time.Location
+}
+
+// scheduleSpecFromValues reads the spec of the schedule form: an interval such as "1h" or "@every 1h",
+// optionally followed by an offset such as "offset 15m", else a standard 5-field cron expression.
+// Cron expressions with seconds or years are not accepted, though the server's grammar has them.
+func scheduleSpecFromValues(v form.Values) (scheduleFormSpec, error) {
+	var spec scheduleFormSpec
+	text := strings.TrimSpace(v["spec"])
+	if text == "" {
+		return spec, errors.New("a spec is required")
+	}
+
+	spec.TimeZoneName = strings.TrimSpace(v["timeZone"])
+	location, err := time.LoadLocation(spec.TimeZoneName)
+	if err != nil {
+		return spec, fmt.Errorf("invalid time zone: %w", err)
+	}
+	spec.location = location
+
+	if spec.Jitter, err = parseOptionalDuration("jitter", v["jitter"]); err != nil {
+		return spec, err
+	}
+
+	everyText, offsetText, hasOffset := strings.Cut(strings.TrimSpace(strings.TrimPrefix(text, "@every")), " offset ")
+	if every, err := time.ParseDuration(strings.TrimSpace(everyText)); err == nil {
+		if every <= 0 {
+			return spec, errors.New("the interval must be positive")
+		}
+		var offset time.Duration
+		if hasOffset {
+			if offset, err = time.ParseDuration(strings.TrimSpace(offsetText)); err != nil {
+				return spec, fmt.Errorf("invalid interval offset: %w", err)
+			}
+			if offset < 0 || offset >= every {
+				return spec, errors.New("the interval offset must be at least zero and less than the interval")
+			}
+		}
+		spec.Intervals = []temporalClient.ScheduleIntervalSpec{{Every: every, Offset: offset}}
+		return spec, nil
+	}
+	if spec.cron, err = cron.ParseStandard(text); err != nil {
+		return spec, fmt.Errorf("invalid spec, neither an interval nor a 5-field cron expression: %w", err)
+	}
+	spec.CronExpressions = []string{text}
+	return spec, nil
+}
+
+// nextScheduleTimes are the next count times after from the spec fires, ignoring jitter.
+// Intervals count from the Unix epoch plus their offset, as on the server.
+func nextScheduleTimes(spec scheduleFormSpec, from time.Time, count int) []time.Time {
+	var times []time.Time
+	t := from.In(spec.location)
+	for len(times) < count {
+		if len(spec.Intervals) > 0 {
+			every, offset := spec.Intervals[0].Every.Nanoseconds(), spec.Intervals[0].Offset.Nanoseconds()
+			t = time.Unix(0, ((t.UnixNano()-offset)/every+1)*every+offset).In(spec.location)
+		} else {
+			t = spec.cron.Next(t)
+		}
+		if t.IsZero() {
+			break
+		}
+		times = append(times, t)
+	}
+	return times
+}
+
+// scheduleSpecEntries describes each entry of a spec, returning the first in the form the schedule form reads.
+// Calendars are read as 5-field cron expressions, so their seconds, year and comment are left out.
+func scheduleSpecEntries(spec *temporalClient.ScheduleSpec) ([]string, string) {
+	var entries, editable []string
+	entries = append(entries, spec.CronExpressions...)
+	editable = append(editable, spec.CronExpressions...)
+	for _, interval := range spec.Intervals {
+		entry := interval.Every.String()
+		if interval.Offset > 0 {
+			entry += fmt.Sprintf(" offset %s", interval.Offset)
+		}
+		entries = append(entries, entry)
+		editable = append(editable, entry)
+	}
+	for _, calendar := range spec.Calendars {
+		entries = append(entries, formatScheduleCalendar(calendar))
+		editable = append(editable, formatScheduleCron(calendar))
+	}
+	if len(entries) == 0 {
+		return nil, ""
+	}
+	return entries, editable[0]
+}
@@ -0,0 +1,79 @@
+package temporaltui
+
+import (
+	"testing"
+	"time"
+
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+)
+
+func TestScheduleSpecEntries(t *testing.T) {
+	weekdays := temporalClient.ScheduleCalendarSpec{
+		Minute:    []temporalClient.ScheduleRange{{Start: 30}},
+		Hour:      []temporalClient.ScheduleRange{{Start: 9}},
+		DayOfWeek: []temporalClient.ScheduleRange{{Start: 1, End: 5}},
+	}
+	withExtras := weekdays
+	withExtras.Second = []temporalClient.ScheduleRange{{Start: 15}}
+	withExtras.Year = []temporalClient.ScheduleRange{{Start: 2024}}
+	withExtras.Comment = "weekday reports"
+
+	tests := []struct {
+		name        string
+		spec        temporalClient.ScheduleSpec
+		wantEntries []string
+		wantForm    string
+	}{
+		{
+			name:        "calendar",
+			spec:        temporalClient.ScheduleSpec{Calendars: []temporalClient.ScheduleCalendarSpec{weekdays}},
+			wantEntries: []string{"30 9 * * 1-5"},
+			wantForm:    "30 9 * * 1-5",
+		},
+		{
+			name: "calendar with a comment",
+			spec: temporalClient.ScheduleSpec{Calendars: []temporalClient.ScheduleCalendarSpec{
+				{Minute: weekdays.Minute, Hour: weekdays.Hour, DayOfWeek: weekdays.DayOfWeek, Comment: "weekday reports"},
+			}},
+			wantEntries: []string{"30 9 * * 1-5 (weekday reports)"},
+			wantForm:    "30 9 * * 1-5",
+		},
+		{
+			name:        "calendar with seconds, year and comment",
+			spec:        temporalClient.ScheduleSpec{Calendars: []temporalClient.ScheduleCalendarSpec{withExtras}},
+			wantEntries: []string{"15 30 9 * * 1-5 2024 (weekday reports)"},
+			wantForm:    "30 9 * * 1-5",
+		},
+		{
+			name: "interval before calendar",
+			spec: temporalClient.ScheduleSpec{
+				Intervals: []temporalClient.ScheduleIntervalSpec{{Every: time.Hour, Offset: 15 * time.Minute}},
+				Calendars: []temporalClient.ScheduleCalendarSpec{weekdays},
+			},
+			wantEntries: []string{"1h0m0s offset 15m0s", "30 9 * * 1-5"},
+			wantForm:    "1h0m0s offset 15m0s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, formSpec := scheduleSpecEntries(&tt.spec)
+			if len(entries) != len(tt.wantEntries) {
+				t.Fatalf("got entries %q, want %q", entries, tt.wantEntries)
+			}
+			for idx := range entries {
+				if entries[idx] != tt.wantEntries[idx] {
+					t.Errorf("got entries %q, want %q", entries, tt.wantEntries)
+				}
+			}
+			if formSpec != tt.wantForm {
+				t.Errorf("got form spec %q, want %q", formSpec, tt.wantForm)
+			}
+			// submitting the form unchanged is valid
+			if _, err := scheduleSpecFromValues(form.Values{"spec": formSpec}); err != nil {
+				t.Errorf("form spec %q not accepted: %v", formSpec, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,439 @@
+package temporaltui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+	"github.com/neomantra/tempted/internal/tui/message"
+)
+
+// scheduleSummary is a row of the Schedules page
+type scheduleSummary struct {
+	entry         *temporalClient.ScheduleListEntry
+	lastRunStatus string
+	running       string
+}
+
+// scheduleSummaryWorkers bounds the schedules described at once
+const scheduleSummaryWorkers = 8
+
+var (
+	closedRunStatusesMtx sync.Mutex
+	// closedRunStatuses holds the statuses of closed runs started by schedules, keyed by workflow and run ID,
+	// as they can no longer change
+	closedRunStatuses = make(map[string]string)
+)
+
+// FetchSchedules lists the schedules of the namespace in the order the server lists them, as the Workflows page does
+func FetchSchedules(client temporalClient.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		iter, err := client.ScheduleClient().List(ctx, temporalClient.ScheduleListOptions{})
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+		var entries []*temporalClient.ScheduleListEntry
+		for iter.HasNext() {
+			entry, err := iter.Next()
+			if err != nil {
+				return message.ErrMsg{Err: err}
+			}
+			entries = append(entries, entry)
+		}
+
+		summaries := make([]scheduleSummary, len(entries))
+		errs := make([]error, len(entries))
+		idxs := make(chan int)
+		var wg sync.WaitGroup
+		for w := 0; w < scheduleSummaryWorkers && w < len(entries); w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for idx := range idxs {
+					summaries[idx], errs[idx] = summarizeSchedule(ctx, client, entries[idx])
+				}
+			}()
+		}
+		for idx := range entries {
+			idxs <- idx
+		}
+		close(idxs)
+		wg.Wait()
+
+		var schedules []scheduleSummary
+		var failed []string
+		var firstErr error
+		for idx, summary := range summaries {
+			var notFound *serviceerror.NotFound
+			if errors.As(errs[idx], &notFound) {
+				// the list comes from visibility, which may still hold a schedule that was just deleted
+				continue
+			}
+			if errs[idx] != nil {
+				failed = append(failed, summary.entry.ID)
+				if firstErr == nil {
+					firstErr = errs[idx]
+				}
+			}
+			schedules = append(schedules, summary)
+		}
+
+		tableHeader, allPageData := schedulesAsTable(schedules)
+		msg := PageLoadedMsg{
+			Page:        SchedulesPage,
+			TableHeader: tableHeader,
+			AllPageRows: allPageData,
+		}
+		if len(failed) > 0 {
+			msg.Err = fmt.Errorf("%d schedules could not be described, e.g. %s: %w", len(failed), failed[0], firstErr)
+		}
+		return msg
+	}
+}
+
+// summarizeSchedule adds what a list entry lacks: the running workflows of the schedule and the status of its last run.
+// What it fails to describe is shown as unknown.
+func summarizeSchedule(ctx context.Context, client temporalClient.Client, entry *temporalClient.ScheduleListEntry) (scheduleSummary, error) {
+	summary := scheduleSummary{entry: entry, lastRunStatus: "unknown", running: "unknown"}
+	desc, err := client.ScheduleClient().GetHandle(ctx, entry.ID).Describe(ctx)
+	if err != nil {
+		return summary, err
+	}
+	summary.running = fmt.Sprint(len(desc.Info.RunningWorkflows))
+
+	recent := entry.RecentActions
+	if len(recent) == 0 || recent[len(recent)-1].StartWorkflowResult == nil {
+		summary.lastRunStatus = ""
+		return summary, nil
+	}
+	run := recent[len(recent)-1].StartWorkflowResult
+	for _, running := range desc.Info.RunningWorkflows {
+		if running.WorkflowID == run.WorkflowID && running.FirstExecutionRunID == run.FirstExecutionRunID {
+			// no need to describe a run the schedule reports running
+			summary.lastRunStatus = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()
+			return summary, nil
+		}
+	}
+
+	runKey := run.WorkflowID + " " + run.FirstExecutionRunID
+	closedRunStatusesMtx.Lock()
+	status, ok := closedRunStatuses[runKey]
+	closedRunStatusesMtx.Unlock()
+	if ok {
+		summary.lastRunStatus = status
+		return summary, nil
+	}
+	resp, err := client.DescribeWorkflowExecution(ctx, run.WorkflowID, run.FirstExecutionRunID)
+	if err != nil {
+		// the run may have passed its retention period
+		return summary, nil
+	}
+	summary.lastRunStatus = resp.WorkflowExecutionInfo.Status.String()
+	if resp.WorkflowExecutionInfo.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		closedRunStatusesMtx.Lock()
+		closedRunStatuses[runKey] = summary.lastRunStatus
+		closedRunStatusesMtx.Unlock()
+	}
+	return summary, nil
+}
+
+func schedulesAsTable(schedules []scheduleSummary) ([]string, []page.Row) {
+	var scheduleRows [][]string
+	var keys []string
+	for _, s := range schedules {
+		state := "Active"
+		if s.entry.Paused {
+			state = "Paused"
+		}
+		nextAction := ""
+		if len(s.entry.NextActionTimes) > 0 {
+			nextAction = formatter.FormatTime(s.entry.NextActionTimes[0])
+		}
+		scheduleRows = append(scheduleRows, []string{
+			s.entry.ID,
+			s.entry.WorkflowType.Name,
+			formatScheduleSpec(s.entry.Spec),
+			state,
+			nextAction,
+			s.lastRunStatus,
+			s.running,
+		})
+		keys = append(keys, s.entry.ID)
+	}
+
+	columns := []string{"Schedule ID", "Workflow Type", "Spec", "State", "Next Action", "Last Run", "Running"}
+	table := formatter.GetRenderedTableAsString(columns, scheduleRows)
+
+	var rows []page.Row
+	for idx, row := range table.ContentRows {
+		rows = append(rows, page.Row{Key: keys[idx], Row: row})
+	}
+	return table.HeaderRows, rows
+}
+
+// formatScheduleSpec summarizes when a schedule fires, e.g. "every 1h0m0s; 0 9 * * 1-5 UTC".
+// The server keeps cron expressions as calendars, so these are shown in cron order.
+func formatScheduleSpec(spec *temporalClient.ScheduleSpec) string {
+	if spec == nil {
+		return ""
+	}
+	var parts []string
+	parts = append(parts, spec.CronExpressions...)
+	for _, interval := range spec.Intervals {
+		part := fmt.Sprintf("every %s", interval.Every)
+		if interval.Offset > 0 {
+			part += fmt.Sprintf(" offset %s", interval.Offset)
+		}
+		parts = append(parts, part)
+	}
+	for _, calendar := range spec.Calendars {
+		parts = append(parts, formatScheduleCalendar(calendar))
+	}
+	summary := strings.Join(parts, "; ")
+	if spec.TimeZoneName != "" {
+		summary += " " + spec.TimeZoneName
+	}
+	return summary
+}
+
+func formatScheduleCalendar(calendar temporalClient.ScheduleCalendarSpec) string {
+	formatted := formatScheduleCron(calendar)
+	if second := formatScheduleRanges(calendar.Second, 0, 59, "0"); second != "0" {
+		formatted = second + " " + formatted
+	}
+	if len(calendar.Year) > 0 {
+		formatted += " " + formatScheduleRanges(calendar.Year, 0, 0, "*")
+	}
+	if calendar.Comment != "" {
+		formatted += fmt.Sprintf(" (%s)", calendar.Comment)
+	}
+	return formatted
+}
+
+// formatScheduleCron writes the minute to day of week of a calendar as a standard 5-field cron expression,
+// leaving out its seconds, year and comment
+func formatScheduleCron(calendar temporalClient.ScheduleCalendarSpec) string {
+	return strings.Join([]string{
+		formatScheduleRanges(calendar.Minute, 0, 59, "0"),
+		formatScheduleRanges(calendar.Hour, 0, 23, "0"),
+		formatScheduleRanges(calendar.DayOfMonth, 1, 31, "*"),
+		formatScheduleRanges(calendar.Month, 1, 12, "*"),
+		formatScheduleRanges(calendar.DayOfWeek, 0, 6, "*"),
+	}, " ")
+}
+
+// formatScheduleRanges writes ranges as a cron field, "*" if they cover min to max
+func formatScheduleRanges(ranges []temporalClient.ScheduleRange, min, max int, empty string) string {
+	if len(ranges) == 0 {
+		return empty
+	}
+	if len(ranges) == 1 && ranges[0].Start == min && ranges[0].End == max && ranges[0].Step <= 1 {
+		return "*"
+	}
+	var fields []string
+	for _, r := range ranges {
+		field := fmt.Sprint(r.Start)
+		if r.End > r.Start {
+			field += fmt.Sprintf("-%d", r.End)
+		}
+		if r.Step > 1 {
+			field += fmt.Sprintf("/%d", r.Step)
+		}
+		fields = append(fields, field)
+	}
+	return strings.Join(fields, ",")
+}
+
+// FetchScheduleDetails describes a schedule: its spec, policies, action and state, its next fire times
+// and its recent actions, whose rows are keyed by the workflow run they started
+func FetchScheduleDetails(client temporalClient.Client, scheduleID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		desc, err := client.ScheduleClient().GetHandle(ctx, scheduleID).Describe(ctx)
+		if err != nil {
+			return message.ErrMsg{Err: err}
+		}
+
+		var rows []page.Row
+		for _, line := range scheduleDescriptionAsLines(scheduleID, desc) {
+			rows = append(rows, page.Row{Key: "", Row: line})
+		}
+
+		rows = append(rows, page.Row{Row: ""}, page.Row{Row: "Next Actions:"})
+		for _, t := range desc.Info.NextActionTimes {
+			rows = append(rows, page.Row{Row: "  " + formatter.FormatTime(t)})
+		}
+
+		rows = append(rows, page.Row{Row: ""}, page.Row{Row: "Running Workflows (enter opens the workflow run):"})
+		for _, run := range desc.Info.RunningWorkflows {
+			rows = append(rows, page.Row{Key: scheduleRunKey(run), Row: fmt.Sprintf("  %s %s", run.WorkflowID, run.FirstExecutionRunID)})
+		}
+
+		rows = append(rows, page.Row{Row: ""}, page.Row{Row: "Recent Actions (enter opens the workflow run):"})
+		// most recent first
+		for idx := len(desc.Info.RecentActions) - 1; idx >= 0; idx-- {
+			action := desc.Info.RecentActions[idx]
+			row := page.Row{Row: fmt.Sprintf("  scheduled %s  started %s", formatter.FormatTime(action.ScheduleTime), formatter.FormatTime(action.ActualTime))}
+			if run := action.StartWorkflowResult; run != nil {
+				row.Key = scheduleRunKey(*run)
+				row.Row += fmt.Sprintf("  %s %s", run.WorkflowID, run.FirstExecutionRunID)
+			}
+			rows = append(rows, row)
+		}
+
+		return PageLoadedMsg{
+			Page:        ScheduleDetailsPage,
+			TableHeader: []string{},
+			AllPageRows: rows,
+		}
+	}
+}
+
+// scheduleRunKey keys a row by a workflow run a schedule started, as a WorkflowKey string
+func scheduleRunKey(run temporalClient.ScheduleWorkflowExecution) string {
+	return fmt.Sprintf("%s %s ", run.WorkflowID, run.FirstExecutionRunID)
+}
+
+func scheduleDescriptionAsLines(scheduleID string, desc *temporalClient.ScheduleDescription) []string {
+	lines := []string{fmt.Sprintf("Schedule ID:      %s", scheduleID)}
+	if state := desc.Schedule.State; state != nil {
+		lines = append(lines,
+			fmt.Sprintf("Paused:           %t", state.Paused),
+			fmt.Sprintf("Note:             %s", state.Note),
+		)
+		if state.LimitedActions {
+			lines = append(lines, fmt.Sprintf("Remaining:        %d actions", state.RemainingActions))
+		}
+	}
+	lines = append(lines,
+		fmt.Sprintf("Created:          %s", formatter.FormatTime(desc.Info.CreatedAt)),
+		fmt.Sprintf("Last Updated:     %s", formatter.FormatTime(desc.Info.LastUpdateAt)),
+		fmt.Sprintf("Actions:          %d taken, %d missed catchup window, %d skipped overlap",
+			desc.Info.NumActions, desc.Info.NumActionsMissedCatchupWindow, desc.Info.NumActionsSkippedOverlap),
+		fmt.Sprintf("Running:          %d", len(desc.Info.RunningWorkflows)),
+	)
+
+	lines = append(lines, "", "Spec:")
+	lines = append(lines, scheduleSpecAsLines(desc.Schedule.Spec)...)
+
+	lines = append(lines, "", "Policies:")
+	if policy := desc.Schedule.Policy; policy != nil {
+		lines = append(lines,
+			fmt.Sprintf("  Overlap:          %s", policy.Overlap),
+			fmt.Sprintf("  Catchup Window:   %s", policy.CatchupWindow),
+			fmt.Sprintf("  Pause On Failure: %t", policy.PauseOnFailure),
+		)
+	}
+
+	lines = append(lines, "", "Action:")
+	lines = append(lines, scheduleActionAsLines(desc.Schedule.Action)...)
+	return lines
+}
+
+func scheduleSpecAsLines(spec *temporalClient.ScheduleSpec) []string {
+	if spec == nil {
+		return nil
+	}
+	var lines []string
+	for _, cron := range spec.CronExpressions {
+		lines = append(lines, fmt.Sprintf("  Cron:             %s", cron))
+	}
+	for _, interval := range spec.Intervals {
+		lines = append(lines, fmt.Sprintf("  Interval:         every %s offset %s", interval.Every, interval.Offset))
+	}
+	for _, calendar := range spec.Calendars {
+		lines = append(lines, fmt.Sprintf("  Calendar:         %s", formatScheduleCalendar(calendar)))
+	}
+	for _, calendar := range spec.Skip {
+		lines = append(lines, fmt.Sprintf("  Skip:             %s", formatScheduleCalendar(calendar)))
+	}
+	lines = append(lines,
+		fmt.Sprintf("  Start At:         %s", formatOptionalTime(spec.StartAt)),
+		fmt.Sprintf("  End At:           %s", formatOptionalTime(spec.EndAt)),
+		fmt.Sprintf("  Jitter:           %s", spec.Jitter),
+		fmt.Sprintf("  Time Zone:        %s", spec.TimeZoneName),
+	)
+	return lines
+}
+
+func scheduleActionAsLines(action temporalClient.ScheduleAction) []string {
+	workflow, ok := action.(*temporalClient.ScheduleWorkflowAction)
+	if !ok {
+		return []string{fmt.Sprintf("  %T", action)}
+	}
+	lines := []string{
+		fmt.Sprintf("  Workflow Type:    %v", workflow.Workflow),
+		fmt.Sprintf("  Workflow ID:      %s", workflow.ID),
+		fmt.Sprintf("  Task Queue:       %s", workflow.TaskQueue),
+		fmt.Sprintf("  Exec Timeout:     %s", formatOptionalDuration(workflow.WorkflowExecutionTimeout)),
+		fmt.Sprintf("  Run Timeout:      %s", formatOptionalDuration(workflow.WorkflowRunTimeout)),
+		fmt.Sprintf("  Task Timeout:     %s", formatOptionalDuration(workflow.WorkflowTaskTimeout)),
+	}
+	if retry := workflow.RetryPolicy; retry != nil {
+		lines = append(lines, fmt.Sprintf("  Retry Policy:     initial %s, backoff %g, max interval %s, max attempts %d",
+			retry.InitialInterval, retry.BackoffCoefficient, retry.MaximumInterval, retry.MaximumAttempts))
+	}
+
+	lines = append(lines, fmt.Sprintf("  Args:             %s", formatSchedulePayloads(workflow.Args)))
+	lines = append(lines, fmt.Sprintf("  Memo:             %s", formatSchedulePayloadMap(workflow.Memo)))
+	lines = append(lines, fmt.Sprintf("  Search Attrs:     %s", formatSchedulePayloadMap(workflow.SearchAttributes)))
+	return lines
+}
+
+// formatSchedulePayloads writes the args of a described schedule, which the SDK leaves encoded, as JSON
+func formatSchedulePayloads(args []interface{}) string {
+	var payloads []*commonpb.Payload
+	for _, arg := range args {
+		if p, ok := arg.(*commonpb.Payload); ok {
+			payloads = append(payloads, p)
+		}
+	}
+	formatted, err := payloadsAsJSON(payloads)
+	if err != nil {
+		return err.Error()
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, []byte(formatted)); err != nil {
+		return formatted
+	}
+	return compact.String()
+}
+
+func formatSchedulePayloadMap(fields map[string]interface{}) string {
+	payloads := make(map[string]*commonpb.Payload, len(fields))
+	for k, v := range fields {
+		if p, ok := v.(*commonpb.Payload); ok {
+			payloads[k] = p
+		}
+	}
+	formatted, err := payloadMapAsJSON(payloads)
+	if err != nil {
+		return err.Error()
+	}
+	return formatted
+}
+
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return formatter.FormatTime(t)
+}
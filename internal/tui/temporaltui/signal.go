@@ -0,0 +1,95 @@
+package temporaltui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	enumspb "go.temporal.io/api/enums/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+)
+
+// SignalNamesMsg carries the names of the signals a workflow has received, offered when signalling it again
+type SignalNamesMsg struct {
+	Page  Page
+	Names []string
+}
+
+func SignalWorkflowForm(keys []WorkflowKey) form.Model {
+	return form.New(form.Config{
+		Title: "Signal Workflow",
+		Info:  workflowKeysInfo(keys),
+		Fields: []form.Field{
+			{Key: "name", Label: "Signal name", Placeholder: "name of the signal"},
+			{Key: "payload", Label: "JSON payload", Placeholder: "optional, e.g. {\"key\": \"value\"}", Kind: form.MultiLine},
+		},
+		Validate: func(v form.Values) error {
+			if strings.TrimSpace(v["name"]) == "" {
+				return errors.New("a signal name is required")
+			}
+			_, err := parseJSONPayload(v["payload"])
+			return err
+		},
+		Confirm: true,
+	})
+}
+
+// FetchSignalNames reads the distinct signal names in the history of a workflow
+func FetchSignalNames(source HistorySource, p Page) tea.Cmd {
+	return func() tea.Msg {
+		// suggestions are a convenience, signalling works without them
+		names, _ := signalNames(context.Background(), source)
+		return SignalNamesMsg{Page: p, Names: names}
+	}
+}
+
+func SignalWorkflows(client temporalClient.Client, keys []WorkflowKey, values form.Values) tea.Cmd {
+	name := strings.TrimSpace(values["name"])
+	payload, err := parseJSONPayload(values["payload"])
+	if err != nil {
+		return func() tea.Msg { return ActionCompletedMsg{Page: WorkflowSignalPage, Err: err} }
+	}
+
+	return runWorkflowsAction(WorkflowSignalPage, fmt.Sprintf("Sent signal %s to", name), keys, func(ctx context.Context, key WorkflowKey) error {
+		return client.SignalWorkflow(ctx, key.WorkflowID, key.RunID, name, payload)
+	})
+}
+
+// parseJSONPayload decodes an optional JSON payload entered in a form, returning nil if it is empty
+func parseJSONPayload(s string) (interface{}, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var payload interface{}
+	if err := unmarshalJSON([]byte(s), &payload); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %w", err)
+	}
+	return payload, nil
+}
+
+func signalNames(ctx context.Context, source HistorySource) ([]string, error) {
+	events, err := source.events(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var names []string
+	for _, event := range events {
+		if event.EventType != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED {
+			continue
+		}
+		name := event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName()
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
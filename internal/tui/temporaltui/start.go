@@ -0,0 +1,426 @@
+package temporaltui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+)
+
+// StartWorkflowParams pre-fills the start workflow form. Args, Memo and SearchAttributes are JSON.
+type StartWorkflowParams struct {
+	WorkflowType, WorkflowID, TaskQueue string
+	Args                                string
+	ExecutionTimeout, RunTimeout        time.Duration
+	IDReusePolicy                       enumspb.WorkflowIdReusePolicy
+	Memo, SearchAttributes              string
+}
+
+// FormMsg carries a form pre-filled from the server, e.g. from an existing workflow, to be opened on Page
+type FormMsg struct {
+	Page Page
+	Form form.Model
+	Err  error
+}
+
+var idReusePolicies = []string{
+	enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE.String(),
+	enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY.String(),
+	enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE.String(),
+	enumspb.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING.String(),
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+func StartWorkflowForm(title string, params StartWorkflowParams) form.Model {
+	return form.New(form.Config{
+		Title:  title,
+		Fields: startWorkflowFields(params),
+		Validate: func(v form.Values) error {
+			_, _, _, err := startWorkflowFromValues(v)
+			return err
+		},
+		Confirm: true,
+	})
+}
+
+func SignalWithStartWorkflowForm(params StartWorkflowParams, signalNames []string) form.Model {
+	fields := append(startWorkflowFields(params),
+		form.Field{Key: "signalName", Label: "Signal name", Placeholder: "name of the signal", Suggestions: signalNames},
+		form.Field{Key: "signalPayload", Label: "Signal payload", Placeholder: "optional JSON payload", Kind: form.MultiLine},
+	)
+	return form.New(form.Config{
+		Title: "Signal With Start Workflow",
+		Info: []string{
+			"Signals the workflow if it is running, otherwise starts it with the signal.",
+		},
+		Fields: fields,
+		Validate: func(v form.Values) error {
+			if _, _, _, err := startWorkflowFromValues(v); err != nil {
+				return err
+			}
+			if strings.TrimSpace(v["signalName"]) == "" {
+				return errors.New("a signal name is required")
+			}
+			_, err := parseJSONPayload(v["signalPayload"])
+			return err
+		},
+		Confirm: true,
+	})
+}
+
+// FetchStartWorkflowForm opens the start form on p pre-filled with the type, task queue, timeouts,
+// memo and search attributes of an existing workflow
+func FetchStartWorkflowForm(client temporalClient.Client, key WorkflowKey, p Page) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		resp, err := client.DescribeWorkflowExecution(ctx, key.WorkflowID, key.RunID)
+		if err != nil {
+			return FormMsg{Page: p, Err: err}
+		}
+		info, config := resp.GetWorkflowExecutionInfo(), resp.GetExecutionConfig()
+
+		params := StartWorkflowParams{
+			WorkflowType: info.GetType().GetName(),
+			TaskQueue:    config.GetTaskQueue().GetName(),
+		}
+		if config.GetWorkflowExecutionTimeout() != nil {
+			params.ExecutionTimeout = *config.GetWorkflowExecutionTimeout()
+		}
+		if config.GetWorkflowRunTimeout() != nil {
+			params.RunTimeout = *config.GetWorkflowRunTimeout()
+		}
+		if params.Memo, err = payloadMapAsJSON(info.GetMemo().GetFields()); err != nil {
+			return FormMsg{Page: p, Err: err}
+		}
+		if params.SearchAttributes, err = payloadMapAsJSON(customSearchAttributes(info.GetSearchAttributes().GetIndexedFields())); err != nil {
+			return FormMsg{Page: p, Err: err}
+		}
+
+		if p == WorkflowSignalWithStartPage {
+			// keep the workflow ID, as signal with start is usually aimed at a long lived entity workflow
+			params.WorkflowID = key.WorkflowID
+			names, _ := signalNames(ctx, HistorySource{Client: client, Key: key})
+			return FormMsg{Page: p, Form: SignalWithStartWorkflowForm(params, names)}
+		}
+		return FormMsg{Page: p, Form: StartWorkflowForm("Start Workflow", params)}
+	}
+}
+
+// FetchRerunWorkflowForm opens the start form pre-filled with the parameters of the WorkflowExecutionStarted
+// event of a closed workflow, including its input, under a new workflow ID
+func FetchRerunWorkflowForm(client temporalClient.Client, key WorkflowKey) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		resp, err := client.DescribeWorkflowExecution(ctx, key.WorkflowID, key.RunID)
+		if err != nil {
+			return FormMsg{Page: WorkflowStartPage, Err: err}
+		}
+		if resp.GetWorkflowExecutionInfo().GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			return FormMsg{Page: WorkflowStartPage, Err: fmt.Errorf("%s is still running, only closed workflows can be re-run", key.WorkflowID)}
+		}
+
+		iter := client.GetWorkflowHistory(ctx, key.WorkflowID, key.RunID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+		if !iter.HasNext() {
+			return FormMsg{Page: WorkflowStartPage, Err: fmt.Errorf("%s has no history", key.WorkflowID)}
+		}
+		event, err := iter.Next()
+		if err != nil {
+			return FormMsg{Page: WorkflowStartPage, Err: err}
+		}
+		attrs := event.GetWorkflowExecutionStartedEventAttributes()
+		if attrs == nil {
+			return FormMsg{Page: WorkflowStartPage, Err: fmt.Errorf("first event of %s is %s, not WorkflowExecutionStarted", key.WorkflowID, event.EventType)}
+		}
+
+		params := StartWorkflowParams{
+			WorkflowType: attrs.GetWorkflowType().GetName(),
+			WorkflowID:   fmt.Sprintf("%s-rerun-%s", key.WorkflowID, uuid.New().String()[:8]),
+			TaskQueue:    attrs.GetTaskQueue().GetName(),
+		}
+		if attrs.GetWorkflowExecutionTimeout() != nil {
+			params.ExecutionTimeout = *attrs.GetWorkflowExecutionTimeout()
+		}
+		if attrs.GetWorkflowRunTimeout() != nil {
+			params.RunTimeout = *attrs.GetWorkflowRunTimeout()
+		}
+		if params.Args, err = payloadsAsJSON(attrs.GetInput().GetPayloads()); err != nil {
+			return FormMsg{Page: WorkflowStartPage, Err: fmt.Errorf("input: %w", err)}
+		}
+		if params.Memo, err = payloadMapAsJSON(attrs.GetMemo().GetFields()); err != nil {
+			return FormMsg{Page: WorkflowStartPage, Err: err}
+		}
+		if params.SearchAttributes, err = payloadMapAsJSON(customSearchAttributes(attrs.GetSearchAttributes().GetIndexedFields())); err != nil {
+			return FormMsg{Page: WorkflowStartPage, Err: err}
+		}
+
+		return FormMsg{Page: WorkflowStartPage, Form: StartWorkflowForm(fmt.Sprintf("Re-run %s", key.WorkflowID), params)}
+	}
+}
+
+func StartWorkflow(client temporalClient.Client, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		options, workflowType, args, err := startWorkflowFromValues(values)
+		if err != nil {
+			return ActionCompletedMsg{Page: WorkflowStartPage, Err: err}
+		}
+		run, err := client.ExecuteWorkflow(ctx, options, workflowType, args...)
+		if err != nil {
+			return ActionCompletedMsg{Page: WorkflowStartPage, Err: err}
+		}
+		return ActionCompletedMsg{
+			Page:     WorkflowStartPage,
+			Message:  fmt.Sprintf("Started %s, run %s", run.GetID(), run.GetRunID()),
+			Workflow: WorkflowKey{WorkflowID: run.GetID(), RunID: run.GetRunID()},
+		}
+	}
+}
+
+func SignalWithStartWorkflow(client temporalClient.Client, values form.Values) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		options, workflowType, args, err := startWorkflowFromValues(values)
+		if err != nil {
+			return ActionCompletedMsg{Page: WorkflowSignalWithStartPage, Err: err}
+		}
+		payload, err := parseJSONPayload(values["signalPayload"])
+		if err != nil {
+			return ActionCompletedMsg{Page: WorkflowSignalWithStartPage, Err: err}
+		}
+
+		// the run ID of the running workflow, if any, tells whether it was signalled or a new run started
+		var runningRunID string
+		if resp, err := client.DescribeWorkflowExecution(ctx, options.ID, ""); err == nil {
+			info := resp.GetWorkflowExecutionInfo()
+			if info.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+				runningRunID = info.GetExecution().GetRunId()
+			}
+		}
+
+		signalName := strings.TrimSpace(values["signalName"])
+		run, err := client.SignalWithStartWorkflow(ctx, options.ID, signalName, payload, options, workflowType, args...)
+		if err != nil {
+			return ActionCompletedMsg{Page: WorkflowSignalWithStartPage, Err: err}
+		}
+
+		message := fmt.Sprintf("Started new run %s of %s with signal %s", run.GetRunID(), run.GetID(), signalName)
+		if run.GetRunID() == runningRunID {
+			message = fmt.Sprintf("Signalled existing run %s of %s with %s", run.GetRunID(), run.GetID(), signalName)
+		}
+		return ActionCompletedMsg{
+			Page:     WorkflowSignalWithStartPage,
+			Message:  message,
+			Workflow: WorkflowKey{WorkflowID: run.GetID(), RunID: run.GetRunID()},
+		}
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+func startWorkflowFields(params StartWorkflowParams) []form.Field {
+	if params.WorkflowID == "" {
+		params.WorkflowID = uuid.New().String()
+	}
+	idReusePolicy := ""
+	if params.IDReusePolicy != enumspb.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		idReusePolicy = params.IDReusePolicy.String()
+	}
+	return []form.Field{
+		{Key: "type", Label: "Workflow type", Value: params.WorkflowType},
+		{Key: "id", Label: "Workflow ID", Value: params.WorkflowID},
+		{Key: "taskQueue", Label: "Task queue", Value: params.TaskQueue},
+		{Key: "args", Label: "JSON args", Value: params.Args, Placeholder: "optional JSON array of arguments, e.g. [\"arg\", 2]", Kind: form.MultiLine},
+		{Key: "executionTimeout", Label: "Execution timeout", Value: formatOptionalDuration(params.ExecutionTimeout), Placeholder: "optional, e.g. 24h"},
+		{Key: "runTimeout", Label: "Run timeout", Value: formatOptionalDuration(params.RunTimeout), Placeholder: "optional, e.g. 1h"},
+		{Key: "idReusePolicy", Label: "ID reuse policy", Value: idReusePolicy, Placeholder: "optional, defaults to AllowDuplicate", Suggestions: idReusePolicies},
+		{Key: "memo", Label: "Memo", Value: params.Memo, Placeholder: "optional JSON object"},
+		{Key: "searchAttributes", Label: "Search attributes", Value: params.SearchAttributes, Placeholder: "optional JSON object"},
+	}
+}
+
+// startWorkflowFromValues parses the values of a start form
+func startWorkflowFromValues(v form.Values) (temporalClient.StartWorkflowOptions, string, []interface{}, error) {
+	var options temporalClient.StartWorkflowOptions
+	workflowType := strings.TrimSpace(v["type"])
+	if workflowType == "" {
+		return options, "", nil, errors.New("a workflow type is required")
+	}
+	options.ID = strings.TrimSpace(v["id"])
+	if options.ID == "" {
+		return options, "", nil, errors.New("a workflow ID is required")
+	}
+	options.TaskQueue = strings.TrimSpace(v["taskQueue"])
+	if options.TaskQueue == "" {
+		return options, "", nil, errors.New("a task queue is required")
+	}
+
+	args, err := parseJSONArgs(v["args"])
+	if err != nil {
+		return options, "", nil, err
+	}
+	if options.WorkflowExecutionTimeout, err = parseOptionalDuration("execution timeout", v["executionTimeout"]); err != nil {
+		return options, "", nil, err
+	}
+	if options.WorkflowRunTimeout, err = parseOptionalDuration("run timeout", v["runTimeout"]); err != nil {
+		return options, "", nil, err
+	}
+	if policy := strings.TrimSpace(v["idReusePolicy"]); policy != "" {
+		value, ok := enumspb.WorkflowIdReusePolicy_value[policy]
+		if !ok {
+			return options, "", nil, fmt.Errorf("unknown ID reuse policy %q, expected one of %s", policy, strings.Join(idReusePolicies, ", "))
+		}
+		options.WorkflowIDReusePolicy = enumspb.WorkflowIdReusePolicy(value)
+	}
+	if options.Memo, err = parseJSONObject("memo", v["memo"]); err != nil {
+		return options, "", nil, err
+	}
+	if options.SearchAttributes, err = parseJSONObject("search attributes", v["searchAttributes"]); err != nil {
+		return options, "", nil, err
+	}
+	return options, workflowType, args, nil
+}
+
+// parseJSONArgs decodes a JSON array of workflow arguments, returning no arguments if it is empty
+func parseJSONArgs(s string) ([]interface{}, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var args []interface{}
+	if err := unmarshalJSON([]byte(s), &args); err != nil {
+		return nil, fmt.Errorf("args must be a JSON array: %w", err)
+	}
+	return args, nil
+}
+
+func parseJSONObject(name, s string) (map[string]interface{}, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var object map[string]interface{}
+	if err := unmarshalJSON([]byte(s), &object); err != nil {
+		return nil, fmt.Errorf("%s must be a JSON object: %w", name, err)
+	}
+	return object, nil
+}
+
+// unmarshalJSON is json.Unmarshal keeping numbers as json.Number, so that integers beyond
+// float64 precision round-trip unchanged
+func unmarshalJSON(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("unexpected data after the JSON value")
+	}
+	return nil
+}
+
+func parseOptionalDuration(name, s string) (time.Duration, error) {
+	if strings.TrimSpace(s) == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return d, nil
+}
+
+func formatOptionalDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
+
+// payloadValue decodes a payload to a value that can be edited as JSON
+func payloadValue(p *commonpb.Payload) (interface{}, error) {
+	encoding := string(p.GetMetadata()["encoding"])
+	switch encoding {
+	case "binary/null":
+		return nil, nil
+	case "json/plain", "json/protobuf":
+		var v interface{}
+		if err := unmarshalJSON(p.GetData(), &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("payloads encoded as %q cannot be edited as JSON", encoding)
+}
+
+// payloadsAsJSON returns the payloads of workflow arguments as an indented JSON array, for editing
+func payloadsAsJSON(payloads []*commonpb.Payload) (string, error) {
+	if len(payloads) == 0 {
+		return "", nil
+	}
+	values := make([]interface{}, 0, len(payloads))
+	for _, p := range payloads {
+		v, err := payloadValue(p)
+		if err != nil {
+			return "", err
+		}
+		values = append(values, v)
+	}
+	b, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func payloadMapAsJSON(fields map[string]*commonpb.Payload) (string, error) {
+	if len(fields) == 0 {
+		return "", nil
+	}
+	values := make(map[string]interface{}, len(fields))
+	for k, p := range fields {
+		v, err := payloadValue(p)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", k, err)
+		}
+		values[k] = v
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// customSearchAttributes leaves out the search attributes maintained by Temporal itself,
+// which can't be set when starting a workflow
+func customSearchAttributes(fields map[string]*commonpb.Payload) map[string]*commonpb.Payload {
+	custom := make(map[string]*commonpb.Payload)
+	for k, p := range fields {
+		if strings.HasPrefix(k, "Temporal") || k == "BuildIds" {
+			continue
+		}
+		custom[k] = p
+	}
+	return custom
+}
@@ -0,0 +1,121 @@
+package temporaltui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+	"github.com/neomantra/tempted/internal/tui/message"
+)
+
+// taskQueueTypes are described for each task queue, workflow tasks and activity tasks being polled separately
+var taskQueueTypes = []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY}
+
+func TaskQueueForm(taskQueue string) form.Model {
+	return form.New(form.Config{
+		Title: "Inspect Task Queue",
+		Fields: []form.Field{
+			{Key: "taskQueue", Label: "Task queue", Placeholder: "name of the task queue", Value: taskQueue},
+		},
+		Validate: func(v form.Values) error {
+			if strings.TrimSpace(v["taskQueue"]) == "" {
+				return errors.New("a task queue is required")
+			}
+			return nil
+		},
+	})
+}
+
+// FetchTaskQueue lists the pollers of the workflow and activity task queues of a name, followed by their backlogs
+func FetchTaskQueue(client temporalClient.Client, namespace, taskQueue string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var pollerRows [][]string
+		var statusRows []string
+		for _, taskQueueType := range taskQueueTypes {
+			resp, err := client.WorkflowService().DescribeTaskQueue(ctx, &workflowservice.DescribeTaskQueueRequest{
+				Namespace:              namespace,
+				TaskQueue:              &taskqueuepb.TaskQueue{Name: taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				TaskQueueType:          taskQueueType,
+				IncludeTaskQueueStatus: true,
+			})
+			if err != nil {
+				return message.ErrMsg{Err: err}
+			}
+			for _, poller := range resp.Pollers {
+				pollerRows = append(pollerRows, []string{
+					taskQueueType.String(),
+					poller.Identity,
+					formatter.FormatTimePtr(poller.LastAccessTime),
+					fmt.Sprintf("%.1f", poller.RatePerSecond),
+				})
+			}
+			statusRows = append(statusRows, taskQueueStatusAsRow(taskQueueType, len(resp.Pollers), resp.TaskQueueStatus))
+		}
+
+		columns := []string{"Type", "Identity", "Last Access", "Rate/s"}
+		table := formatter.GetRenderedTableAsString(columns, pollerRows)
+
+		var rows []page.Row
+		for _, row := range table.ContentRows {
+			rows = append(rows, page.Row{Key: "", Row: row})
+		}
+		rows = append(rows, page.Row{Row: ""})
+		for _, row := range statusRows {
+			rows = append(rows, page.Row{Key: "", Row: row})
+		}
+		return PageLoadedMsg{
+			Page:        TaskQueuePage,
+			TableHeader: table.HeaderRows,
+			AllPageRows: rows,
+		}
+	}
+}
+
+// taskQueueStatusAsRow summarizes the pollers and, where the server reports it, the backlog of a task queue type
+func taskQueueStatusAsRow(taskQueueType enumspb.TaskQueueType, pollers int, status *taskqueuepb.TaskQueueStatus) string {
+	row := fmt.Sprintf("%s pollers: %d", taskQueueType, pollers)
+	if pollers == 0 {
+		row += " (no worker is polling)"
+	}
+	if status != nil {
+		row += fmt.Sprintf(", backlog: ~%d tasks, dispatch rate: %.1f/s", status.BacklogCountHint, status.RatePerSecond)
+	}
+	return row
+}
+
+// TaskQueueMsg carries the task queue of a workflow, to inspect its pollers
+type TaskQueueMsg struct {
+	TaskQueue string
+	Err       error
+}
+
+func FetchWorkflowTaskQueue(client temporalClient.Client, key WorkflowKey) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		resp, err := client.DescribeWorkflowExecution(ctx, key.WorkflowID, key.RunID)
+		if err != nil {
+			return TaskQueueMsg{Err: err}
+		}
+		return TaskQueueMsg{TaskQueue: resp.WorkflowExecutionInfo.TaskQueue}
+	}
+}
+
+// RunningWorkflowsQuery is the visibility query of the running workflows of a task queue
+func RunningWorkflowsQuery(taskQueue string) string {
+	return fmt.Sprintf("TaskQueue=%q AND ExecutionStatus=\"Running\"", taskQueue)
+}
@@ -0,0 +1,41 @@
+package temporaltui
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+)
+
+func TerminateWorkflowForm(keys []WorkflowKey) form.Model {
+	return form.New(form.Config{
+		Title: "Terminate Workflow",
+		Info:  workflowKeysInfo(keys),
+		Fields: []form.Field{
+			{Key: "reason", Label: "Reason", Placeholder: "why the workflow is being terminated"},
+			{Key: "details", Label: "Details", Placeholder: "optional details recorded with the termination"},
+		},
+		Validate: func(v form.Values) error {
+			if strings.TrimSpace(v["reason"]) == "" {
+				return errors.New("a reason is required")
+			}
+			return nil
+		},
+		Confirm: true,
+	})
+}
+
+func TerminateWorkflows(client temporalClient.Client, keys []WorkflowKey, values form.Values) tea.Cmd {
+	var details []interface{}
+	if d := strings.TrimSpace(values["details"]); d != "" {
+		details = append(details, d)
+	}
+
+	return runWorkflowsAction(WorkflowTermPage, "Terminated", keys, func(ctx context.Context, key WorkflowKey) error {
+		return client.TerminateWorkflow(ctx, key.WorkflowID, key.RunID, values["reason"], details...)
+	})
+}
@@ -0,0 +1,352 @@
+package temporaltui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	updatepb "go.temporal.io/api/update/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalClient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+
+	"github.com/neomantra/tempted/internal/tui/components/form"
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+)
+
+// WorkflowUpdate is an update sent or re-attached to this session, whose outcome is read again from its handle
+type WorkflowUpdate struct {
+	Key      WorkflowKey
+	Name     string
+	UpdateID string
+	Args     string
+	Sent     time.Time
+
+	handle temporalClient.WorkflowUpdateHandle
+	// err is why the update could not be sent, if it has no handle
+	err error
+	// rejected is whether the update's validator rejected it, rather than its handler failing
+	rejected bool
+}
+
+// UpdateCompletedMsg carries an update once the workflow has completed or rejected it
+type UpdateCompletedMsg struct {
+	// Page is the page the update was sent or re-attached from
+	Page   Page
+	Update WorkflowUpdate
+}
+
+func UpdateWorkflowForm(key WorkflowKey) form.Model {
+	return form.New(form.Config{
+		Title: "Update Workflow",
+		Info:  workflowKeysInfo([]WorkflowKey{key}),
+		Fields: []form.Field{
+			{Key: "name", Label: "Update name", Placeholder: "name of the update handler"},
+			{Key: "args", Label: "JSON args", Placeholder: "optional JSON array, e.g. [\"arg1\", 2]", Kind: form.MultiLine},
+			{Key: "updateID", Label: "Update ID", Placeholder: "optional, generated if empty"},
+		},
+		Validate: func(v form.Values) error {
+			if strings.TrimSpace(v["name"]) == "" {
+				return errors.New("an update name is required")
+			}
+			_, err := parseJSONArgs(v["args"])
+			return err
+		},
+		Confirm: true,
+	})
+}
+
+// UpdateWorkflow sends an update and waits for the workflow to complete or reject it
+func UpdateWorkflow(client temporalClient.Client, key WorkflowKey, values form.Values) tea.Cmd {
+	update := WorkflowUpdate{
+		Key:      key,
+		Name:     strings.TrimSpace(values["name"]),
+		UpdateID: strings.TrimSpace(values["updateID"]),
+		Args:     strings.TrimSpace(values["args"]),
+	}
+	if update.UpdateID == "" {
+		update.UpdateID = uuid.New().String()
+	}
+
+	return func() (msg tea.Msg) {
+		// SDK v1.22's workflowClientInterceptor.UpdateWorkflow panics with "unspported update outcome: Incomplete"
+		// when the server returns before the update completes, e.g. on a timeout. Remove once the SDK returns an error.
+		defer func() {
+			if r := recover(); r != nil {
+				update.handle, update.err = nil, fmt.Errorf("update did not complete: %v", r)
+				msg = UpdateCompletedMsg{Page: WorkflowUpdatePage, Update: update}
+			}
+		}()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		args, err := parseJSONArgs(update.Args)
+		if err != nil {
+			update.err = err
+			return UpdateCompletedMsg{Page: WorkflowUpdatePage, Update: update}
+		}
+		update.Sent = time.Now()
+		update.handle, update.err = client.UpdateWorkflowWithOptions(ctx, &temporalClient.UpdateWorkflowWithOptionsRequest{
+			UpdateID:   update.UpdateID,
+			WorkflowID: key.WorkflowID,
+			RunID:      key.RunID,
+			UpdateName: update.Name,
+			Args:       args,
+		})
+		if update.err == nil {
+			// the handle holds the outcome already, as the SDK waits for the update to complete
+			if err := update.handle.Get(ctx, nil); temporal.IsApplicationError(err) {
+				accepted, err := updateAcceptedEvent(ctx, client, key, update.UpdateID)
+				update.rejected = err == nil && accepted == nil
+			}
+		}
+		return UpdateCompletedMsg{Page: WorkflowUpdatePage, Update: update}
+	}
+}
+
+// updateAcceptedEvent returns the event of the workflow's history that records the acceptance of an update, or nil if
+// there is none, as when a validator rejected it. A handler failing an update it accepted is recorded after the acceptance.
+func updateAcceptedEvent(ctx context.Context, client temporalClient.Client, key WorkflowKey, updateID string) (*historypb.HistoryEvent, error) {
+	iter := client.GetWorkflowHistory(ctx, key.WorkflowID, key.RunID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if event.EventType != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED {
+			continue
+		}
+		if event.GetWorkflowExecutionUpdateAcceptedEventAttributes().GetAcceptedRequest().GetMeta().GetUpdateId() == updateID {
+			return event, nil
+		}
+	}
+	return nil, nil
+}
+
+func AttachUpdateForm() form.Model {
+	return form.New(form.Config{
+		Title: "Re-attach to Update",
+		Info:  []string{"Reads the outcome of an update sent earlier, e.g. in another session or by another client."},
+		Fields: []form.Field{
+			{Key: "workflowID", Label: "Workflow ID"},
+			{Key: "runID", Label: "Run ID", Placeholder: "optional, the latest run if empty"},
+			{Key: "updateID", Label: "Update ID"},
+		},
+		Validate: func(v form.Values) error {
+			if strings.TrimSpace(v["workflowID"]) == "" {
+				return errors.New("a workflow ID is required")
+			}
+			if strings.TrimSpace(v["updateID"]) == "" {
+				return errors.New("an update ID is required")
+			}
+			return nil
+		},
+	})
+}
+
+// AttachUpdate polls the server for the outcome of an update, waiting for it to complete. Its name, args and
+// time come from the workflow's history, as the server keeps only the outcome for polling.
+func AttachUpdate(client temporalClient.Client, namespace string, values form.Values) tea.Cmd {
+	update := WorkflowUpdate{
+		Key: WorkflowKey{
+			WorkflowID: strings.TrimSpace(values["workflowID"]),
+			RunID:      strings.TrimSpace(values["runID"]),
+		},
+		UpdateID: strings.TrimSpace(values["updateID"]),
+	}
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ref := &updatepb.UpdateRef{
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: update.Key.WorkflowID, RunId: update.Key.RunID},
+			UpdateId:          update.UpdateID,
+		}
+		resp, err := client.WorkflowService().PollWorkflowExecutionUpdate(ctx, &workflowservice.PollWorkflowExecutionUpdateRequest{
+			Namespace: namespace,
+			UpdateRef: ref,
+			WaitPolicy: &updatepb.WaitPolicy{
+				LifecycleStage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED,
+			},
+		})
+		if err != nil {
+			return ActionCompletedMsg{Page: WorkflowUpdateAttachPage, Err: fmt.Errorf("update %s: %w", update.UpdateID, err)}
+		}
+		if resp.GetOutcome() == nil {
+			return ActionCompletedMsg{Page: WorkflowUpdateAttachPage, Err: fmt.Errorf("update %s has not completed yet", update.UpdateID)}
+		}
+		update.handle = polledUpdateHandle{ref: ref, outcome: resp.GetOutcome()}
+
+		event, err := updateAcceptedEvent(ctx, client, update.Key, update.UpdateID)
+		if err == nil && event != nil {
+			input := event.GetWorkflowExecutionUpdateAcceptedEventAttributes().GetAcceptedRequest().GetInput()
+			update.Name = input.GetName()
+			update.Args = compactPayloadsJSON(input.GetArgs().GetPayloads())
+			if event.EventTime != nil {
+				update.Sent = *event.EventTime
+			}
+		}
+		update.rejected = err == nil && event == nil && resp.GetOutcome().GetFailure() != nil
+		return UpdateCompletedMsg{Page: WorkflowUpdateAttachPage, Update: update}
+	}
+}
+
+// compactPayloadsJSON shows update args on one line, or nothing if they cannot be shown as JSON
+func compactPayloadsJSON(payloads []*commonpb.Payload) string {
+	s, err := payloadsAsJSON(payloads)
+	if err != nil {
+		return ""
+	}
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, []byte(s)); err != nil {
+		return s
+	}
+	return compacted.String()
+}
+
+// polledUpdateHandle is the handle of a re-attached update, holding the outcome polled from the server
+type polledUpdateHandle struct {
+	ref     *updatepb.UpdateRef
+	outcome *updatepb.Outcome
+}
+
+func (h polledUpdateHandle) WorkflowID() string {
+	return h.ref.GetWorkflowExecution().GetWorkflowId()
+}
+
+func (h polledUpdateHandle) RunID() string {
+	return h.ref.GetWorkflowExecution().GetRunId()
+}
+
+func (h polledUpdateHandle) UpdateID() string {
+	return h.ref.GetUpdateId()
+}
+
+func (h polledUpdateHandle) Get(ctx context.Context, valuePtr interface{}) error {
+	if failure := h.outcome.GetFailure(); failure != nil {
+		return temporal.GetDefaultFailureConverter().FailureToError(failure)
+	}
+	success := h.outcome.GetSuccess()
+	if valuePtr == nil || len(success.GetPayloads()) == 0 {
+		return nil
+	}
+	return converter.GetDefaultDataConverter().FromPayloads(success, valuePtr)
+}
+
+// Outcome reads the result of the update from its handle, returning how it ended and the decoded result or error
+func (u WorkflowUpdate) Outcome() (string, interface{}, error) {
+	if u.handle == nil {
+		return "failed", nil, u.err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var result interface{}
+	if err := u.handle.Get(ctx, &result); err != nil {
+		if u.rejected {
+			return "rejected", nil, err
+		}
+		// returned by the update's handler, or the update could not be delivered
+		return "failed", nil, err
+	}
+	return "completed", result, nil
+}
+
+// Message summarizes the outcome of the update for a toast
+func (u WorkflowUpdate) Message() (string, error) {
+	outcome, _, err := u.Outcome()
+	if err != nil {
+		return "", fmt.Errorf("update %s %s: %w", u.Name, outcome, err)
+	}
+	return fmt.Sprintf("Update %s completed on %s", u.Name, u.Key.WorkflowID), nil
+}
+
+// FetchWorkflowUpdates lists the updates sent or re-attached to this session with their outcomes
+func FetchWorkflowUpdates(updates []WorkflowUpdate) tea.Cmd {
+	return func() tea.Msg {
+		var updateRows [][]string
+		var keys []string
+		// most recent first
+		for idx := len(updates) - 1; idx >= 0; idx-- {
+			u := updates[idx]
+			outcome, _, _ := u.Outcome()
+			updateRows = append(updateRows, []string{
+				formatter.FormatTime(u.Sent),
+				u.Key.WorkflowID,
+				u.Key.RunID,
+				u.Name,
+				u.UpdateID,
+				outcome,
+			})
+			keys = append(keys, strconv.Itoa(idx))
+		}
+
+		columns := []string{"Sent", "Workflow ID", "Run ID", "Update Name", "Update ID", "Outcome"}
+		table := formatter.GetRenderedTableAsString(columns, updateRows)
+
+		var rows []page.Row
+		for idx, row := range table.ContentRows {
+			rows = append(rows, page.Row{Key: keys[idx], Row: row})
+		}
+		return PageLoadedMsg{
+			Page:        WorkflowUpdatesPage,
+			TableHeader: table.HeaderRows,
+			AllPageRows: rows,
+		}
+	}
+}
+
+// FetchWorkflowUpdateResult shows the outcome of an update with its decoded result or error
+func FetchWorkflowUpdateResult(u WorkflowUpdate) tea.Cmd {
+	return func() tea.Msg {
+		outcome, result, err := u.Outcome()
+		lines := []string{
+			fmt.Sprintf("Workflow ID: %s", u.Key.WorkflowID),
+			fmt.Sprintf("Run ID:      %s", u.Key.RunID),
+			fmt.Sprintf("Update Name: %s", u.Name),
+			fmt.Sprintf("Update ID:   %s", u.UpdateID),
+			fmt.Sprintf("Sent:        %s", formatter.FormatTime(u.Sent)),
+			fmt.Sprintf("Args:        %s", u.Args),
+			fmt.Sprintf("Outcome:     %s", outcome),
+			"",
+		}
+		if err != nil {
+			lines = append(lines, "Error:")
+			lines = append(lines, strings.Split(err.Error(), "\n")...)
+		} else if pretty, err := prettyPrintJSONObject(result); err == nil {
+			lines = append(lines, "Result:")
+			lines = append(lines, strings.Split(pretty, "\n")...)
+		}
+
+		var rows []page.Row
+		for _, line := range lines {
+			rows = append(rows, page.Row{Key: "", Row: line})
+		}
+		return PageLoadedMsg{
+			Page:        WorkflowUpdateResultPage,
+			TableHeader: []string{},
+			AllPageRows: rows,
+		}
+	}
+}
+
+// UpdateIdxFromKey returns the index in the session's updates of a row of the Updates page
+func UpdateIdxFromKey(key string) int {
+	idx, _ := strconv.Atoi(key)
+	return idx
+}
@@ -0,0 +1,36 @@
+package temporaltui
+
+import (
+	"context"
+	"testing"
+
+	failurepb "go.temporal.io/api/failure/v1"
+	updatepb "go.temporal.io/api/update/v1"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+)
+
+func TestPolledUpdateHandle(t *testing.T) {
+	success, err := converter.GetDefaultDataConverter().ToPayloads(map[string]interface{}{"approved": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handle := polledUpdateHandle{outcome: &updatepb.Outcome{Value: &updatepb.Outcome_Success{Success: success}}}
+	var result map[string]interface{}
+	if err := handle.Get(context.Background(), &result); err != nil {
+		t.Fatal(err)
+	}
+	if result["approved"] != true {
+		t.Errorf("got result %v", result)
+	}
+
+	failure := &failurepb.Failure{
+		Message:     "not enough stock",
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{Type: "StockError"}},
+	}
+	handle = polledUpdateHandle{outcome: &updatepb.Outcome{Value: &updatepb.Outcome_Failure{Failure: failure}}}
+	err = handle.Get(context.Background(), &result)
+	if !temporal.IsApplicationError(err) || err.Error() != "not enough stock (type: StockError, retryable: true)" {
+		t.Errorf("got error %v", err)
+	}
+}
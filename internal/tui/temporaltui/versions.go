@@ -0,0 +1,127 @@
+package temporaltui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/neomantra/tempted/internal/tui/components/page"
+	"github.com/neomantra/tempted/internal/tui/formatter"
+	"github.com/neomantra/tempted/internal/tui/message"
+)
+
+// unversionedBuildID stands for the build ID of pollers that have not opted into worker versioning
+const unversionedBuildID = "(unversioned)"
+
+// FetchBuildIDs lists the worker build-ID version sets of a task queue, newest first, with the pollers
+// reporting each build ID, followed by build IDs pollers report that are in no set.
+// Where the server has worker versioning disabled, only the build IDs of pollers are listed, with the reason.
+func FetchBuildIDs(client temporalClient.Client, namespace, taskQueue string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sets, setsErr := client.GetWorkerBuildIdCompatibility(ctx, &temporalClient.GetWorkerBuildIdCompatibilityOptions{TaskQueue: taskQueue})
+
+		// pollers counts the pollers of each task queue type by the build ID they report
+		pollers := make(map[string]map[enumspb.TaskQueueType]int)
+		for _, taskQueueType := range taskQueueTypes {
+			resp, err := client.WorkflowService().DescribeTaskQueue(ctx, &workflowservice.DescribeTaskQueueRequest{
+				Namespace:     namespace,
+				TaskQueue:     &taskqueuepb.TaskQueue{Name: taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				TaskQueueType: taskQueueType,
+			})
+			if err != nil {
+				return message.ErrMsg{Err: err}
+			}
+			for _, poller := range resp.Pollers {
+				buildID := poller.WorkerVersionCapabilities.GetBuildId()
+				if buildID == "" {
+					buildID = unversionedBuildID
+				}
+				if pollers[buildID] == nil {
+					pollers[buildID] = make(map[enumspb.TaskQueueType]int)
+				}
+				pollers[buildID][taskQueueType]++
+			}
+		}
+
+		tableHeader, allPageData := buildIDsAsTable(sets, pollers)
+		if setsErr != nil {
+			allPageData = append(allPageData,
+				page.Row{Row: ""},
+				page.Row{Row: fmt.Sprintf("Version sets unavailable, showing poller build IDs only: %v", setsErr)},
+			)
+		}
+		return PageLoadedMsg{
+			Page:        BuildIDsPage,
+			TableHeader: tableHeader,
+			AllPageRows: allPageData,
+		}
+	}
+}
+
+func buildIDsAsTable(sets *temporalClient.WorkerBuildIDVersionSets, pollers map[string]map[enumspb.TaskQueueType]int) ([]string, []page.Row) {
+	var buildIDRows [][]string
+	inSet := make(map[string]bool)
+	defaultBuildID := ""
+	if sets != nil {
+		defaultBuildID = sets.Default()
+		for setIdx := len(sets.Sets) - 1; setIdx >= 0; setIdx-- {
+			buildIDs := sets.Sets[setIdx].BuildIDs
+			for idx := len(buildIDs) - 1; idx >= 0; idx-- {
+				buildID := buildIDs[idx]
+				isDefault := ""
+				if buildID == defaultBuildID {
+					isDefault = "default"
+				} else if idx == len(buildIDs)-1 {
+					isDefault = "set default"
+				}
+				buildIDRows = append(buildIDRows, []string{fmt.Sprint(setIdx + 1), buildID, isDefault, formatBuildIDPollers(pollers[buildID])})
+				inSet[buildID] = true
+			}
+		}
+	}
+
+	// build IDs that pollers report but no set holds, e.g. of workers not yet added to the version sets
+	var otherBuildIDs []string
+	for buildID := range pollers {
+		if !inSet[buildID] {
+			otherBuildIDs = append(otherBuildIDs, buildID)
+		}
+	}
+	sort.Strings(otherBuildIDs)
+	for _, buildID := range otherBuildIDs {
+		buildIDRows = append(buildIDRows, []string{"-", buildID, "", formatBuildIDPollers(pollers[buildID])})
+	}
+
+	columns := []string{"Set", "Build ID", "Default", "Pollers"}
+	table := formatter.GetRenderedTableAsString(columns, buildIDRows)
+
+	var rows []page.Row
+	for _, row := range table.ContentRows {
+		rows = append(rows, page.Row{Key: "", Row: row})
+	}
+	return table.HeaderRows, rows
+}
+
+// formatBuildIDPollers counts the pollers reporting a build ID by task queue type, e.g. "Workflow 2, Activity 1"
+func formatBuildIDPollers(counts map[enumspb.TaskQueueType]int) string {
+	var parts []string
+	for _, taskQueueType := range taskQueueTypes {
+		if n := counts[taskQueueType]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", taskQueueType, n))
+		}
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, ", ")
+}
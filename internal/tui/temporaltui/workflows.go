@@ -9,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	proto "github.com/gogo/protobuf/proto"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	temporalClient "go.temporal.io/sdk/client"
@@ -27,10 +28,10 @@ type WorkflowKey struct {
 
 ///////////////////////////////////////////////////////////////////////////////
 
-func FetchWorkflowExecutions(client temporalClient.Client) tea.Cmd {
+// FetchWorkflowExecutions lists the workflows matching a visibility query, all of them if it is empty,
+// showing running workflows in cancelRequested as "cancel requested"
+func FetchWorkflowExecutions(client temporalClient.Client, query string, cancelRequested map[WorkflowKey]bool) tea.Cmd {
 	return func() tea.Msg {
-		// fetch all the workflows
-		query := "" // TODO:
 		workflowExecutions, err := getWorkflowExecutions(context.Background(), client, query)
 		if err != nil {
 			return message.ErrMsg{Err: err}
@@ -45,7 +46,7 @@ func FetchWorkflowExecutions(client temporalClient.Client) tea.Cmd {
 		// 	// return jobResults[x].Name < jobResults[y].Name
 		// })
 
-		tableHeader, allPageData := workflowExecutionsAsTable(workflowExecutions)
+		tableHeader, allPageData := workflowExecutionsAsTable(workflowExecutions, cancelRequested)
 		return PageLoadedMsg{
 			Page:        WorkflowsPage,
 			TableHeader: tableHeader,
@@ -167,23 +168,29 @@ func getWorkflowExecutions(ctx context.Context, c temporalClient.Client, query s
 	}
 }
 
-func workflowExecutionsAsTable(infos []*workflowpb.WorkflowExecutionInfo) ([]string, []page.Row) {
+func workflowExecutionsAsTable(infos []*workflowpb.WorkflowExecutionInfo, cancelRequested map[WorkflowKey]bool) ([]string, []page.Row) {
 	var workflowExecutionRows [][]string
 	var keys []string
 	for _, info := range infos {
+		key := formatWorkflowKey(info)
+		status := info.Status.String()
+		if info.Status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING && cancelRequested[WorkflowKeyFromString(key)] {
+			status = cancelRequestedStatus
+		}
 		workflowExecutionRows = append(workflowExecutionRows, []string{
 			info.Type.Name,
 			info.Execution.WorkflowId,
 			info.Execution.RunId,
 			info.TaskQueue,
+			status,
 			formatter.FormatTimePtr(info.StartTime),
 			formatter.FormatTimePtr(info.ExecutionTime),
 			formatter.FormatTimePtr(info.CloseTime),
 		})
-		keys = append(keys, formatWorkflowKey(info))
+		keys = append(keys, key)
 	}
 
-	columns := []string{"Type", "Workflow ID", "Run ID", "Task Queue", "Start Time", "Exec Time", "End Time"}
+	columns := []string{"Type", "Workflow ID", "Run ID", "Task Queue", "Status", "Start Time", "Exec Time", "End Time"}
 	table := formatter.GetRenderedTableAsString(columns, workflowExecutionRows)
 
 	var rows []page.Row